@@ -0,0 +1,38 @@
+package devtui
+
+import (
+	"io"
+	"testing"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+func TestDefaultPaletteFollowsTerminalBackground(t *testing.T) {
+	dark := lipgloss.NewRenderer(io.Discard)
+	dark.SetHasDarkBackground(true)
+	light := lipgloss.NewRenderer(io.Discard)
+	light.SetHasDarkBackground(false)
+
+	darkStyle := newTuiStyleWithRenderer(dark, nil, nil)
+	lightStyle := newTuiStyleWithRenderer(light, nil, nil)
+
+	if darkStyle.Foreground != DefaultPalette().Foreground {
+		t.Errorf("Expected dark background to use DefaultPalette, got foreground %s", darkStyle.Foreground)
+	}
+	if lightStyle.Foreground != LightPalette().Foreground {
+		t.Errorf("Expected light background to use LightPalette, got foreground %s", lightStyle.Foreground)
+	}
+	if darkStyle.Foreground == lightStyle.Foreground {
+		t.Errorf("Expected default foreground to differ between backgrounds, both %s", darkStyle.Foreground)
+	}
+}
+
+func TestExplicitPaletteIgnoresTerminalBackground(t *testing.T) {
+	light := lipgloss.NewRenderer(io.Discard)
+	light.SetHasDarkBackground(false)
+
+	palette := DefaultPalette()
+	if got := newTuiStyleWithRenderer(light, palette, nil); got.ColorPalette != palette {
+		t.Error("Expected explicit TuiConfig.Color to take precedence over background detection")
+	}
+}
@@ -0,0 +1,45 @@
+package devtui
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	. "github.com/cdvelop/tinystring"
+	"github.com/charmbracelet/lipgloss"
+)
+
+func TestANSIContentIsNotRestyled(t *testing.T) {
+	h := DefaultTUIForTest()
+	h.viewport.Width = 120
+	// Mark error styling so it is visible regardless of the terminal color profile
+	h.errStyle = lipgloss.NewStyle().Transform(func(s string) string { return "<err>" + s + "</err>" })
+
+	tab := h.NewTabSection("Build", "go build output")
+	h.AddLogger("GoBuild", false, "", tab)
+	ts := tab.(*tabSection)
+	writer := &handlerWriter{tabSection: ts, handlerName: "GoBuild"}
+
+	colored := "\x1b[31merror: undefined: foo\x1b[0m"
+	fmt.Fprintln(writer, colored)
+	fmt.Fprintln(writer, "error: plain failure")
+
+	if len(ts.tabContents) != 2 {
+		t.Fatalf("Expected 2 messages, got %d", len(ts.tabContents))
+	}
+
+	got := h.formatMessage(ts.tabContents[0])
+	if !strings.Contains(got, colored) {
+		t.Errorf("Expected original ANSI content to be kept, got %q", got)
+	}
+	if strings.Contains(got, "<err>") {
+		t.Errorf("ANSI content should not be wrapped by errStyle, got %q", got)
+	}
+
+	if ts.tabContents[1].Type != Msg.Error {
+		t.Fatalf("Expected plain error to be detected as Error, got %v", ts.tabContents[1].Type)
+	}
+	if plain := h.formatMessage(ts.tabContents[1]); !strings.Contains(plain, "<err>") {
+		t.Errorf("Plain error content should still use errStyle, got %q", plain)
+	}
+}
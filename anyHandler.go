@@ -3,6 +3,8 @@ package devtui
 import (
 	"sync"
 	"time"
+
+	. "github.com/cdvelop/tinystring"
 )
 
 // ============================================================================
@@ -18,6 +20,8 @@ const (
 	handlerTypeWriter
 	handlerTypeTrackerWriter
 	handlerTypeInteractive // NEW: Interactive content handler
+	handlerTypeTable       // Read-only tabular display
+	handlerTypeToggle      // On/off setting flipped with Space/Enter
 )
 
 // anyHandler - Estructura privada que unifica todos los handlers
@@ -40,9 +44,33 @@ type anyHandler struct {
 	editModeFunc func() bool                        // NEW: Auto edit mode activation
 	changeFunc   func(string, chan<- string) // Edit/Execution (nueva firma)
 	executeFunc  func(chan<- string)            // Execution únicamente (nueva firma)
+	changeTypedFunc func(string, chan<- MessageUpdate) // Edit/Execution/Interactive opcional: ChangeTyped()/ExecuteTyped()
 	timeoutFunc  func() time.Duration               // Edit/Execution
 	getOpIDFunc  func() string                      // Tracking
 	setOpIDFunc  func(string)                       // Tracking
+	maxLenFunc   func() int                         // Edit/Interactive opcional: MaxLength()
+	defaultFunc  func() string                      // Edit/Interactive opcional: DefaultValue()
+	placeholderFunc func() string                   // Edit/Interactive opcional: Placeholder()
+	onEditFunc   func(string)                       // Edit/Interactive opcional: OnEdit() live preview
+	preserveOnEscFunc func() bool                 // Edit/Interactive opcional: PreserveOnEsc() conserva el borrador
+	selectAllFunc func() bool                     // Edit/Interactive opcional: SelectAllOnEdit() reemplaza el valor al teclear
+	numericFunc   func() (int, int, int)           // Edit/Interactive opcional: Numeric() min, max, step para Up/Down
+	fileSystemFunc func() DirReader               // Edit/Interactive opcional: FileSystem() completa rutas con Tab (HandlerFilePicker)
+	completeFunc  func(string) []string           // Edit/Interactive opcional: Complete() candidatos para Tab (Completer)
+	headersFunc  func() []string                    // Table únicamente
+	rowsFunc     func() [][]string                  // Table únicamente
+
+	structuredFields bool // Field logger: content is rendered as colored key=value pairs
+
+	focusableFunc func() bool // Opcional: Focusable() false = navegación lo salta
+
+	contentHeightFunc func() int // Display opcional: ContentHeight() líneas visibles con scroll interno
+
+	contentFooterFunc func() string // Display/Table opcional: ContentFooter() línea resumen fija bajo el contenido
+
+	helpFunc func() string // Opcional: Help() texto de ayuda del campo (tecla ?)
+
+	retryFunc func() (int, time.Duration) // Edit/Execution/Interactive/Toggle opcional: RetryPolicy() intentos y espera
 }
 
 // ============================================================================
@@ -116,6 +144,14 @@ func (a *anyHandler) GetLastOperationID() string {
 	return a.lastOpID
 }
 
+// MaxLength returns the optional input length limit (0 = no limit)
+func (a *anyHandler) MaxLength() int {
+	if a.maxLenFunc != nil {
+		return a.maxLenFunc()
+	}
+	return 0
+}
+
 func (a *anyHandler) WaitingForUser() bool {
 	if a.editModeFunc != nil {
 		return a.editModeFunc()
@@ -123,6 +159,89 @@ func (a *anyHandler) WaitingForUser() bool {
 	return false
 }
 
+// DefaultValue returns the optional default value and whether the handler provides one
+func (a *anyHandler) DefaultValue() (string, bool) {
+	if a.defaultFunc != nil {
+		return a.defaultFunc(), true
+	}
+	return "", false
+}
+
+// Placeholder returns the optional hint shown while the field is empty
+func (a *anyHandler) Placeholder() string {
+	if a.placeholderFunc != nil {
+		return a.placeholderFunc()
+	}
+	return ""
+}
+
+// OnEdit forwards the in-progress edit value to the optional live preview hook
+func (a *anyHandler) OnEdit(current string) {
+	if a.onEditFunc != nil {
+		a.onEditFunc(current)
+	}
+}
+
+// Focusable reports whether navigation may land on the handler (default true)
+func (a *anyHandler) Focusable() bool {
+	if a.focusableFunc != nil {
+		return a.focusableFunc()
+	}
+	return true
+}
+
+// detectContentFooter wires the optional ContentFooter() summary of display/table handlers
+func (a *anyHandler) detectContentFooter(h any) {
+	if summarizer, ok := h.(interface{ ContentFooter() string }); ok {
+		a.contentFooterFunc = summarizer.ContentFooter
+	}
+}
+
+// detectHelp wires the optional Help() description shared by all field handlers
+func (a *anyHandler) detectHelp(h any) {
+	if helper, ok := h.(interface{ Help() string }); ok {
+		a.helpFunc = helper.Help
+	}
+}
+
+// detectFocusable wires the optional Focusable() method shared by all field handlers
+func (a *anyHandler) detectFocusable(h any) {
+	if focuser, ok := h.(interface{ Focusable() bool }); ok {
+		a.focusableFunc = focuser.Focusable
+	}
+}
+
+// detectEditOptions wires optional methods shared by editable handlers (Edit/Interactive)
+func (a *anyHandler) detectEditOptions(h any) {
+	if limiter, ok := h.(interface{ MaxLength() int }); ok {
+		a.maxLenFunc = limiter.MaxLength
+	}
+	if defaulter, ok := h.(interface{ DefaultValue() string }); ok {
+		a.defaultFunc = defaulter.DefaultValue
+	}
+	if placeholder, ok := h.(interface{ Placeholder() string }); ok {
+		a.placeholderFunc = placeholder.Placeholder
+	}
+	if previewer, ok := h.(interface{ OnEdit(current string) }); ok {
+		a.onEditFunc = previewer.OnEdit
+	}
+	if keeper, ok := h.(interface{ PreserveOnEsc() bool }); ok {
+		a.preserveOnEscFunc = keeper.PreserveOnEsc
+	}
+	if selector, ok := h.(interface{ SelectAllOnEdit() bool }); ok {
+		a.selectAllFunc = selector.SelectAllOnEdit
+	}
+	if stepper, ok := h.(interface{ Numeric() (min, max, step int) }); ok {
+		a.numericFunc = stepper.Numeric
+	}
+	if completer, ok := h.(Completer); ok {
+		a.completeFunc = completer.Complete
+	}
+	if picker, ok := h.(interface{ FileSystem() DirReader }); ok {
+		a.fileSystemFunc = picker.FileSystem
+	}
+}
+
 // ============================================================================
 // Factory Methods
 // ============================================================================
@@ -150,6 +269,12 @@ func NewEditHandler(h HandlerEdit, timeout time.Duration, tracker MessageTracker
 
 	// REMOVED: Hybrid Content() detection - use HandlerInteractive instead
 
+	anyH.detectEditOptions(h)
+	anyH.detectTypedProgress(h)
+	anyH.detectRetryPolicy(h)
+	anyH.detectFocusable(h)
+	anyH.detectHelp(h)
+
 	// Configurar tracking opcional
 	if tracker != nil {
 		anyH.getOpIDFunc = tracker.GetLastOperationID
@@ -163,7 +288,7 @@ func NewEditHandler(h HandlerEdit, timeout time.Duration, tracker MessageTracker
 }
 
 func NewDisplayHandler(h HandlerDisplay, color string) *anyHandler {
-	return &anyHandler{
+	anyH := &anyHandler{
 		handlerType:  handlerTypeDisplay,
 		timeout:      0,         // Display no requiere timeout
 		nameFunc:     h.Name,    // Solo Name()
@@ -174,6 +299,35 @@ func NewDisplayHandler(h HandlerDisplay, color string) *anyHandler {
 		setOpIDFunc:  func(string) {},
 		handlerColor: color, // NEW: Store handler color
 	}
+	anyH.detectFocusable(h)
+	anyH.detectHelp(h)
+	if sized, ok := h.(interface{ ContentHeight() int }); ok {
+		anyH.contentHeightFunc = sized.ContentHeight
+	}
+	anyH.detectContentFooter(h)
+	return anyH
+}
+
+func NewTableHandler(h HandlerTable, color string) *anyHandler {
+	anyH := &anyHandler{
+		handlerType:  handlerTypeTable,
+		timeout:      0, // Table no requiere timeout
+		nameFunc:     h.Name,
+		headersFunc:  func() []string { return nil },
+		rowsFunc:     h.Rows,
+		editableFunc: func() bool { return false },
+		getOpIDFunc:  func() string { return "" },
+		setOpIDFunc:  func(string) {},
+		origHandler:  h,
+		handlerColor: color,
+	}
+	if titled, ok := h.(interface{ Headers() []string }); ok {
+		anyH.headersFunc = titled.Headers
+	}
+	anyH.detectFocusable(h)
+	anyH.detectHelp(h)
+	anyH.detectContentFooter(h)
+	return anyH
 }
 
 func NewExecutionHandler(h HandlerExecution, timeout time.Duration, color string) *anyHandler {
@@ -210,6 +364,52 @@ func NewExecutionHandler(h HandlerExecution, timeout time.Duration, color string
 
 	// REMOVED: Hybrid Content() detection - use HandlerInteractive instead
 
+	anyH.detectFocusable(h)
+	anyH.detectHelp(h)
+	anyH.detectTypedProgress(h)
+	anyH.detectRetryPolicy(h)
+
+	return anyH
+}
+
+// toggleMark renders the state of a HandlerToggle in the footer
+func toggleMark(on bool) string {
+	if on {
+		return "[x]"
+	}
+	return "[ ]"
+}
+
+func NewToggleHandler(h HandlerToggle, timeout time.Duration, color string) *anyHandler {
+	anyH := &anyHandler{
+		handlerType:  handlerTypeToggle,
+		timeout:      timeout,
+		nameFunc:     h.Name,
+		labelFunc:    h.Label,
+		valueFunc:    func() string { return toggleMark(h.Current()) },
+		editableFunc: func() bool { return false },
+		changeFunc: func(_ string, progress chan<- string) {
+			h.Change(!h.Current(), func(msgs ...any) {
+				progress <- Translate(msgs...).String()
+			})
+		},
+		timeoutFunc:  func() time.Duration { return timeout },
+		origHandler:  h,
+		handlerColor: color,
+	}
+
+	if tracker, ok := h.(MessageTracker); ok {
+		anyH.getOpIDFunc = tracker.GetLastOperationID
+		anyH.setOpIDFunc = tracker.SetLastOperationID
+	} else {
+		anyH.getOpIDFunc = func() string { return "" }
+		anyH.setOpIDFunc = func(string) {}
+	}
+
+	anyH.detectFocusable(h)
+	anyH.detectHelp(h)
+	anyH.detectRetryPolicy(h)
+
 	return anyH
 }
 
@@ -253,6 +453,12 @@ func NewInteractiveHandler(h HandlerInteractive, timeout time.Duration, tracker
 		handlerColor: color, // NEW: Store handler color
 	}
 
+	anyH.detectEditOptions(h)
+	anyH.detectTypedProgress(h)
+	anyH.detectRetryPolicy(h)
+	anyH.detectFocusable(h)
+	anyH.detectHelp(h)
+
 	// Configure optional tracking
 	if tracker != nil {
 		anyH.getOpIDFunc = tracker.GetLastOperationID
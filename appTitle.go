@@ -0,0 +1,17 @@
+package devtui
+
+// SetTitle changes the app name shown in the header (TuiConfig.AppName) and in the
+// shortcuts help, e.g. when a tool switches projects. Safe from any goroutine.
+func (h *DevTUI) SetTitle(name string) {
+	h.titleMu.Lock()
+	h.AppName = name
+	h.titleMu.Unlock()
+	h.RefreshUI()
+}
+
+// appTitle returns the current app name (SetTitle)
+func (h *DevTUI) appTitle() string {
+	h.titleMu.RLock()
+	defer h.titleMu.RUnlock()
+	return h.AppName
+}
@@ -0,0 +1,42 @@
+package devtui
+
+import (
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/charmbracelet/x/ansi"
+)
+
+func TestSetTitleUpdatesHeader(t *testing.T) {
+	h := DefaultTUIForTest()
+	h.viewport.Width = 80
+	h.viewport.Height = 10
+	tab := h.NewTabSection("Logs", "App title test")
+	h.activeTab = tab.(*tabSection).index
+
+	h.SetTitle("proj")
+	if header := ansi.Strip(h.headerView()); !strings.Contains(header, "proj/Logs") {
+		t.Errorf("Expected new app name in header, got %q", header)
+	}
+}
+
+func TestSetTitleConcurrentWithRender(t *testing.T) {
+	h := DefaultTUIForTest()
+	h.viewport.Width = 80
+	h.viewport.Height = 10
+	h.NewTabSection("Logs", "App title test")
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for i := 0; i < 100; i++ {
+			h.SetTitle("proj")
+		}
+	}()
+	for i := 0; i < 100; i++ {
+		h.headerView()
+	}
+	wg.Wait()
+}
@@ -0,0 +1,69 @@
+package devtui
+
+import (
+	"testing"
+)
+
+func TestAutoColorAssignsDistinctColors(t *testing.T) {
+	tui := NewTUI(&TuiConfig{
+		AppName:   "AutoColor",
+		ExitChan:  make(chan bool),
+		AutoColor: true,
+	})
+	tui.SetTestMode(true)
+
+	tab := tui.NewTabSection("Colors", "Auto color test")
+	tui.AddHandler(NewTestEditableHandler("First", "1"), 0, "", tab)
+	tui.AddHandler(NewTestEditableHandler("Second", "2"), 0, "", tab)
+	tui.AddHandler(NewTestNonEditableHandler("Third", "3"), 0, "", tab)
+
+	ts := tab.(*tabSection)
+	if len(ts.fieldHandlers) != 3 {
+		t.Fatalf("Expected 3 fields, got %d", len(ts.fieldHandlers))
+	}
+
+	seen := make(map[string]bool)
+	for i, f := range ts.fieldHandlers {
+		color := f.handler.handlerColor
+		if color == "" {
+			t.Errorf("Field %d: expected auto-assigned color, got empty", i)
+		}
+		if seen[color] {
+			t.Errorf("Field %d: color %s already assigned to another handler", i, color)
+		}
+		seen[color] = true
+	}
+}
+
+func TestAutoColorKeepsExplicitColor(t *testing.T) {
+	tui := NewTUI(&TuiConfig{
+		AppName:   "AutoColor",
+		ExitChan:  make(chan bool),
+		AutoColor: true,
+	})
+	tui.SetTestMode(true)
+
+	tab := tui.NewTabSection("Colors", "Auto color test")
+	tui.AddHandler(NewTestEditableHandler("Explicit", "1"), 0, "#123456", tab)
+
+	ts := tab.(*tabSection)
+	if got := ts.fieldHandlers[0].handler.handlerColor; got != "#123456" {
+		t.Errorf("Expected explicit color #123456 to be kept, got %q", got)
+	}
+}
+
+func TestAutoColorDisabledLeavesColorEmpty(t *testing.T) {
+	tui := NewTUI(&TuiConfig{
+		AppName:  "NoAutoColor",
+		ExitChan: make(chan bool),
+	})
+	tui.SetTestMode(true)
+
+	tab := tui.NewTabSection("Colors", "Auto color test")
+	tui.AddHandler(NewTestEditableHandler("Plain", "1"), 0, "", tab)
+
+	ts := tab.(*tabSection)
+	if got := ts.fieldHandlers[0].handler.handlerColor; got != "" {
+		t.Errorf("Expected empty color without AutoColor, got %q", got)
+	}
+}
@@ -0,0 +1,39 @@
+package devtui
+
+import (
+	"testing"
+)
+
+func TestBlankMessagesAreSuppressed(t *testing.T) {
+	h := DefaultTUIForTest()
+	tab := h.NewTabSection("Logs", "Blank messages test")
+	log := h.AddLogger("Printer", false, "", tab)
+	ts := tab.(*tabSection)
+
+	log("")
+	log("   ")
+	log("\n\t")
+
+	if len(ts.tabContents) != 0 {
+		t.Fatalf("Expected blank messages to be dropped, got %d: %+v", len(ts.tabContents), ts.tabContents)
+	}
+
+	log("visible")
+	if len(ts.tabContents) != 1 || ts.tabContents[0].Content != "visible" {
+		t.Fatalf("Expected only the visible message, got %+v", ts.tabContents)
+	}
+}
+
+func TestKeepBlankMessagesOptOut(t *testing.T) {
+	h := DefaultTUIForTest()
+	h.KeepBlankMessages = true
+	tab := h.NewTabSection("Logs", "Blank messages test")
+	log := h.AddLogger("Printer", false, "", tab)
+	ts := tab.(*tabSection)
+
+	log("")
+
+	if len(ts.tabContents) != 1 {
+		t.Fatalf("Expected the blank message to be kept with KeepBlankMessages, got %d", len(ts.tabContents))
+	}
+}
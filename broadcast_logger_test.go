@@ -0,0 +1,58 @@
+package devtui
+
+import (
+	"strings"
+	"testing"
+)
+
+func tabHasContent(ts *tabSection, content string) bool {
+	ts.mu.RLock()
+	defer ts.mu.RUnlock()
+	for _, c := range ts.tabContents {
+		if strings.Contains(c.Content, content) {
+			return true
+		}
+	}
+	return false
+}
+
+func TestBroadcastLoggerWritesToAllTabs(t *testing.T) {
+	h := DefaultTUIForTest()
+
+	build := h.NewTabSection("BUILD", "Compiler").(*tabSection)
+	all := h.NewTabSection("ALL", "All Logs").(*tabSection)
+	other := h.NewTabSection("OTHER", "Unrelated").(*tabSection)
+
+	log := build.NewBroadcastLogger("Compiler", all)
+	log("Build started")
+
+	if !tabHasContent(build, "Build started") {
+		t.Error("Expected message in primary tab")
+	}
+	if !tabHasContent(all, "Build started") {
+		t.Error("Expected message in aggregate tab")
+	}
+	if tabHasContent(other, "Build started") {
+		t.Error("Message should not appear in unrelated tab")
+	}
+}
+
+func TestAddBroadcastLoggerUsesSameColor(t *testing.T) {
+	h := NewTUI(&TuiConfig{ExitChan: make(chan bool), AutoColor: true})
+	h.SetTestMode(true)
+
+	build := h.NewTabSection("BUILD", "Compiler")
+	all := h.NewTabSection("ALL", "All Logs")
+
+	log := h.AddBroadcastLogger("Compiler", "", build, all)
+	log("Build started")
+
+	primary := build.(*tabSection).getWritingHandler("Compiler")
+	mirror := all.(*tabSection).getWritingHandler("Compiler")
+	if primary == nil || mirror == nil {
+		t.Fatal("Broadcast logger should be registered in both tabs")
+	}
+	if primary.handlerColor == "" || primary.handlerColor != mirror.handlerColor {
+		t.Errorf("Expected same non-empty color in both tabs, got %q and %q", primary.handlerColor, mirror.handlerColor)
+	}
+}
@@ -0,0 +1,63 @@
+package devtui
+
+import (
+	. "github.com/cdvelop/tinystring"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// clearPrompt is shown in the footer while a clear waits for confirmation (TuiConfig.ConfirmClear)
+func clearPrompt() string {
+	return Translate(terms.Clear, D.All, terms.Messages).String() + "? y/n"
+}
+
+// ClearContents removes all messages of the given tab section.
+//
+// Example:
+//
+//	logs := tui.NewTabSection("LOGS", "Output")
+//	tui.ClearContents(logs)
+func (t *DevTUI) ClearContents(tabSection any) {
+	ts := t.validateTabSection(tabSection, "ClearContents")
+	ts.clearContents()
+	if ts.index == t.activeTab {
+		t.updateViewport()
+	}
+}
+
+// clearContents drops all messages and counters of the tab.
+// A paused tab stays paused on an empty snapshot with no pending messages.
+func (ts *tabSection) clearContents() {
+	ts.mu.Lock()
+	ts.tabContents = nil
+	ts.readCount = ts.messageCount
+	if ts.paused {
+		ts.frozen = nil
+		ts.pausedAt = ts.messageCount
+	}
+	ts.mu.Unlock()
+}
+
+// requestClear clears the active tab (Ctrl+L), asking first when TuiConfig.ConfirmClear is set
+func (h *DevTUI) requestClear() {
+	if h.ConfirmClear {
+		h.pendingClear = true
+		return
+	}
+	h.clearActiveTab()
+}
+
+func (h *DevTUI) clearActiveTab() {
+	if h.activeTab < len(h.TabSections) {
+		h.TabSections[h.activeTab].clearContents()
+		h.updateViewport()
+	}
+}
+
+// handleClearConfirmation consumes the key answering the clear prompt: y confirms, anything else cancels
+func (h *DevTUI) handleClearConfirmation(msg tea.KeyMsg) (bool, tea.Cmd) {
+	h.pendingClear = false
+	if msg.Type == tea.KeyRunes && len(msg.Runes) == 1 && (msg.Runes[0] == 'y' || msg.Runes[0] == 'Y') {
+		h.clearActiveTab()
+	}
+	return false, nil
+}
@@ -0,0 +1,64 @@
+package devtui
+
+import (
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func setupClearTest(confirm bool) (*DevTUI, *tabSection) {
+	h := NewTUI(&TuiConfig{ExitChan: make(chan bool), ConfirmClear: confirm})
+	h.SetTestMode(true)
+	h.viewport.Width = 80
+	h.viewport.Height = 20
+
+	tab := h.NewTabSection("Logs", "Clear test")
+	h.activeTab = tab.(*tabSection).index
+	log := h.AddLogger("Writer", false, "", tab)
+	log("one")
+	log("two")
+	return h, tab.(*tabSection)
+}
+
+func TestClearWithoutConfirmation(t *testing.T) {
+	h, ts := setupClearTest(false)
+
+	h.handleKeyboard(tea.KeyMsg{Type: tea.KeyCtrlL})
+	if len(ts.tabContents) != 0 {
+		t.Errorf("Expected Ctrl+L to clear the tab, got %d messages", len(ts.tabContents))
+	}
+}
+
+func TestClearRequiresConfirmation(t *testing.T) {
+	h, ts := setupClearTest(true)
+
+	h.handleKeyboard(tea.KeyMsg{Type: tea.KeyCtrlL})
+	if len(ts.tabContents) != 2 {
+		t.Fatalf("Single clear key must not clear when confirmation is required, got %d messages", len(ts.tabContents))
+	}
+	if footer := h.footerView(); !strings.Contains(footer, clearPrompt()) {
+		t.Errorf("Expected confirmation prompt in footer, got %q", footer)
+	}
+
+	// Any other key cancels
+	h.handleKeyboard(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'n'}})
+	if len(ts.tabContents) != 2 || h.pendingClear {
+		t.Fatalf("Expected clear to be cancelled")
+	}
+
+	// Confirmed sequence clears
+	h.handleKeyboard(tea.KeyMsg{Type: tea.KeyCtrlL})
+	h.handleKeyboard(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'y'}})
+	if len(ts.tabContents) != 0 {
+		t.Errorf("Expected confirmed clear to remove messages, got %d", len(ts.tabContents))
+	}
+}
+
+func TestClearContentsAPI(t *testing.T) {
+	h, ts := setupClearTest(true)
+	h.ClearContents(ts)
+	if len(ts.tabContents) != 0 {
+		t.Errorf("Expected ClearContents to clear without prompt, got %d messages", len(ts.tabContents))
+	}
+}
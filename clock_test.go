@@ -0,0 +1,67 @@
+package devtui
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/charmbracelet/x/ansi"
+)
+
+func TestPinnedClockRendersSameTimestamp(t *testing.T) {
+	fixed := time.Date(2024, 5, 1, 9, 30, 15, 0, time.Local)
+	h := NewTUI(&TuiConfig{
+		AppName:  "Clock",
+		ExitChan: make(chan bool),
+		Logger:   func(...any) {},
+		Now:      func() time.Time { return fixed },
+	})
+	h.SetTestMode(true)
+	h.viewport.Width = 80
+	h.viewport.Height = 20
+	tab := h.NewTabSection("Build", "Clock test")
+	log := h.AddLogger("Builder", false, "", tab)
+	track := h.AddLogger("Deployer", true, "", tab)
+	ts := tab.(*tabSection)
+
+	log("first")
+	time.Sleep(2 * time.Millisecond)
+	log("second")
+	track("deploying")
+	track("deployed") // updated line gets the pinned time too
+
+	ids := make(map[string]bool)
+	for _, msg := range ts.tabContents {
+		if ids[msg.Id] {
+			t.Errorf("Expected unique message ids with a pinned clock, %q repeated", msg.Id)
+		}
+		ids[msg.Id] = true
+
+		rendered := ansi.Strip(h.formatMessage(msg))
+		if !strings.HasPrefix(rendered, "09:30:15 ") {
+			t.Errorf("Expected pinned timestamp 09:30:15, got %q", rendered)
+		}
+	}
+	if len(ts.tabContents) != 3 {
+		t.Errorf("Expected 3 messages, got %d", len(ts.tabContents))
+	}
+}
+
+func TestPinnedClockRelativeAge(t *testing.T) {
+	now := time.Date(2024, 5, 1, 9, 30, 0, 0, time.Local)
+	h := NewTUI(&TuiConfig{
+		AppName:       "Clock",
+		ExitChan:      make(chan bool),
+		Logger:        func(...any) {},
+		TimestampMode: TimestampRelative,
+		Now:           func() time.Time { return now },
+	})
+	tab := h.NewTabSection("Build", "Clock test")
+	h.AddLogger("Builder", false, "", tab)("started")
+	ts := tab.(*tabSection)
+
+	now = now.Add(90 * time.Second)
+	if got := strings.TrimSpace(ansi.Strip(h.generateTimestamp(ts.tabContents[0].Timestamp))); got != "1m" {
+		t.Errorf("Expected relative age 1m from the pinned clock, got %q", got)
+	}
+}
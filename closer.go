@@ -0,0 +1,68 @@
+package devtui
+
+import (
+	"fmt"
+	"time"
+)
+
+// Closer is an optional interface for field handlers holding resources (connections,
+// goroutines). Close is called once when the TUI exits (Ctrl+C or Stop), bounded by
+// TuiConfig.CloseTimeout; errors are reported through Logger.
+type Closer interface {
+	Close() error
+}
+
+// defaultCloseTimeout bounds handler Close calls unless TuiConfig.CloseTimeout is set
+const defaultCloseTimeout = 2 * time.Second
+
+// closeHandlers calls Close on every handler implementing Closer concurrently and waits
+// at most CloseTimeout, so a hanging Close never blocks exit
+func (h *DevTUI) closeHandlers() {
+	type closeResult struct {
+		name string
+		err  error
+	}
+
+	var pending int
+	results := make(chan closeResult, h.countClosers())
+	for _, tab := range h.TabSections {
+		for _, f := range tab.fieldHandlers {
+			closer, ok := f.handler.origHandler.(Closer)
+			if !ok {
+				continue
+			}
+			pending++
+			go func(name string) {
+				results <- closeResult{name, closer.Close()}
+			}(f.handler.Name())
+		}
+	}
+
+	timeout := time.After(h.CloseTimeout)
+	for ; pending > 0; pending-- {
+		select {
+		case res := <-results:
+			if res.err != nil && h.Logger != nil {
+				h.Logger(fmt.Sprintf("Close %s: %v", res.name, res.err))
+			}
+		case <-timeout:
+			if h.Logger != nil {
+				h.Logger(fmt.Sprintf("Close timed out after %v (%d handlers pending)", h.CloseTimeout, pending))
+			}
+			return
+		}
+	}
+}
+
+// countClosers returns how many field handlers implement Closer
+func (h *DevTUI) countClosers() int {
+	count := 0
+	for _, tab := range h.TabSections {
+		for _, f := range tab.fieldHandlers {
+			if _, ok := f.handler.origHandler.(Closer); ok {
+				count++
+			}
+		}
+	}
+	return count
+}
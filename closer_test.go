@@ -0,0 +1,105 @@
+package devtui
+
+import (
+	"errors"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// closableHandler is an editable handler implementing Closer
+type closableHandler struct {
+	*TestEditableHandler
+	mu     sync.Mutex
+	closed int
+	err    error
+	block  chan struct{}
+}
+
+func (c *closableHandler) Close() error {
+	if c.block != nil {
+		<-c.block
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.closed++
+	return c.err
+}
+
+func (c *closableHandler) closeCount() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.closed
+}
+
+func TestCloserCalledOnCtrlC(t *testing.T) {
+	var mu sync.Mutex
+	var logs []string
+	h := DefaultTUIForTest(func(messages ...any) {
+		mu.Lock()
+		defer mu.Unlock()
+		for _, m := range messages {
+			if s, ok := m.(string); ok {
+				logs = append(logs, s)
+			}
+		}
+	})
+	tab := h.NewTabSection("Closer", "close test")
+	handler := &closableHandler{
+		TestEditableHandler: NewTestEditableHandler("DB", "conn"),
+		err:                 errors.New("connection reset"),
+	}
+	h.AddHandler(handler, 0, "", tab)
+
+	_, cmd := h.handleKeyboard(tea.KeyMsg{Type: tea.KeyCtrlC})
+	if cmd == nil {
+		t.Fatal("expected quit command on Ctrl+C")
+	}
+	if got := handler.closeCount(); got != 1 {
+		t.Fatalf("expected Close to be called once before quit, got %d", got)
+	}
+
+	// Stop after Ctrl+C must not close handlers again
+	h.Stop()
+	if got := handler.closeCount(); got != 1 {
+		t.Errorf("expected Close to run only once, got %d", got)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	if !strings.Contains(strings.Join(logs, "\n"), "connection reset") {
+		t.Errorf("expected Close error to be logged, got %v", logs)
+	}
+}
+
+func TestCloserTimeoutDoesNotBlockExit(t *testing.T) {
+	h := NewTUI(&TuiConfig{
+		AppName:      "CloserTimeout",
+		ExitChan:     make(chan bool),
+		Logger:       func(...any) {},
+		CloseTimeout: 20 * time.Millisecond,
+	})
+	h.SetTestMode(true)
+	tab := h.NewTabSection("Closer", "close test")
+	block := make(chan struct{})
+	defer close(block)
+	h.AddHandler(&closableHandler{
+		TestEditableHandler: NewTestEditableHandler("Hang", "x"),
+		block:               block,
+	}, 0, "", tab)
+
+	done := make(chan struct{})
+	go func() {
+		h.handleKeyboard(tea.KeyMsg{Type: tea.KeyCtrlC})
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("hanging Close blocked the quit sequence")
+	}
+}
@@ -0,0 +1,79 @@
+package devtui
+
+import (
+	"strings"
+	"testing"
+)
+
+func snapshotContents(ts *tabSection) []tabContent {
+	ts.mu.RLock()
+	defer ts.mu.RUnlock()
+	return append([]tabContent(nil), ts.tabContents...)
+}
+
+func TestCoalesceRepeatedMessages(t *testing.T) {
+	h := NewTUI(&TuiConfig{ExitChan: make(chan bool), CoalesceMessages: true})
+	h.SetTestMode(true)
+	tab := h.NewTabSection("Logs", "Coalescing")
+	log := h.AddLogger("Net", false, "", tab)
+
+	for i := 0; i < 5; i++ {
+		log("connection retry")
+	}
+
+	contents := snapshotContents(tab.(*tabSection))
+	if len(contents) != 1 {
+		t.Fatalf("Expected 1 coalesced line, got %d", len(contents))
+	}
+	if formatted := h.formatMessage(contents[0]); !strings.Contains(formatted, "connection retry (x5)") {
+		t.Errorf("Expected '(x5)' counter, got %q", formatted)
+	}
+}
+
+func TestCoalesceCounterResetsOnDifferentMessage(t *testing.T) {
+	h := NewTUI(&TuiConfig{ExitChan: make(chan bool), CoalesceMessages: true})
+	h.SetTestMode(true)
+	tab := h.NewTabSection("Logs", "Coalescing")
+	netLog := h.AddLogger("Net", false, "", tab)
+	dbLog := h.AddLogger("DB", false, "", tab)
+
+	netLog("connection retry")
+	netLog("connection retry")
+	netLog("connected")
+	netLog("connection retry")
+	dbLog("connection retry") // same content, different handler: not coalesced
+
+	contents := snapshotContents(tab.(*tabSection))
+	if len(contents) != 4 {
+		t.Fatalf("Expected 4 lines, got %d", len(contents))
+	}
+	if contents[0].repeatCount != 2 {
+		t.Errorf("Expected first line counter 2, got %d", contents[0].repeatCount)
+	}
+	for i, c := range contents[1:] {
+		if c.repeatCount > 1 {
+			t.Errorf("Line %d should not be coalesced, counter %d", i+1, c.repeatCount)
+		}
+	}
+}
+
+func TestCoalescePerTabOverride(t *testing.T) {
+	h := DefaultTUIForTest()
+	quiet := h.NewTabSection("Quiet", "Coalescing on")
+	noisy := h.NewTabSection("Noisy", "Coalescing off")
+	h.SetTabCoalescing(quiet, true)
+
+	quietLog := h.AddLogger("A", false, "", quiet)
+	noisyLog := h.AddLogger("B", false, "", noisy)
+	for i := 0; i < 3; i++ {
+		quietLog("tick")
+		noisyLog("tick")
+	}
+
+	if n := len(snapshotContents(quiet.(*tabSection))); n != 1 {
+		t.Errorf("Expected 1 line in coalescing tab, got %d", n)
+	}
+	if n := len(snapshotContents(noisy.(*tabSection))); n != 3 {
+		t.Errorf("Expected 3 lines in default tab, got %d", n)
+	}
+}
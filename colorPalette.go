@@ -0,0 +1,24 @@
+package devtui
+
+// colorPaletteMsg rebuilds the styles inside the tea loop (DevTUI.SetColorPalette)
+type colorPaletteMsg struct {
+	palette *ColorPalette
+}
+
+// SetColorPalette switches the colors at runtime (e.g. a light/dark theme toggle): every
+// derived style is rebuilt from palette and the screen is redrawn. nil restores the
+// default palette for the terminal background. Safe to call while the program is running.
+func (h *DevTUI) SetColorPalette(palette *ColorPalette) {
+	if h.running.Load() && h.tea != nil {
+		h.tea.Send(colorPaletteMsg{palette: palette})
+		return
+	}
+	h.applyColorPalette(palette)
+}
+
+// applyColorPalette rebuilds tuiStyle keeping the renderer (NoColor) and MessageColors
+func (h *DevTUI) applyColorPalette(palette *ColorPalette) {
+	h.tuiStyle = newTuiStyleWithRenderer(h.renderer, palette, h.MessageColors)
+	h.Color = h.ColorPalette
+	h.updateViewport()
+}
@@ -0,0 +1,39 @@
+package devtui
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestSetColorPaletteRebuildsStyles(t *testing.T) {
+	h := NewTUI(&TuiConfig{
+		AppName:           "Theme",
+		ExitChan:          make(chan bool),
+		ForceColorProfile: "truecolor",
+		Logger:            func(...any) {},
+	})
+	h.SetTestMode(true)
+
+	if out := h.errStyle.Render("x"); !strings.Contains(out, "38;2;255;0;0") {
+		t.Fatalf("Expected default #FF0000 error foreground, got %q", out)
+	}
+
+	light := DefaultPalette()
+	light.Foreground = "#000000"
+	light.Background = "#FFFFFF"
+	light.Error = "#0000FF"
+	h.SetColorPalette(light)
+
+	out := h.errStyle.Render("x")
+	if !strings.Contains(out, "38;2;0;0;255") {
+		t.Errorf("Expected #0000FF error foreground after switching themes, got %q", out)
+	}
+	if h.Color != light {
+		t.Error("Expected TuiConfig.Color to follow the new palette")
+	}
+
+	h.SetColorPalette(nil)
+	if out := h.errStyle.Render("x"); !strings.Contains(out, "38;2;255;0;0") {
+		t.Errorf("Expected nil to restore the default palette, got %q", out)
+	}
+}
@@ -0,0 +1,57 @@
+package devtui
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+func TestForceColorProfileDegradesHexColors(t *testing.T) {
+	previous := lipgloss.ColorProfile()
+
+	cases := []struct {
+		profile string
+		want    string   // escape sequence expected for the #FF0000 error color
+		deny    []string // escape sequences the profile must not emit
+	}{
+		{"truecolor", "38;2;255;0;0", nil},
+		{"256", "38;5;", []string{"38;2;"}},
+		{"16", "\x1b[", []string{"38;2;", "38;5;"}},
+		{"ascii", "", []string{"\x1b["}},
+	}
+
+	for _, c := range cases {
+		t.Run(c.profile, func(t *testing.T) {
+			h := NewTUI(&TuiConfig{
+				AppName:           "Profile",
+				ExitChan:          make(chan bool),
+				ForceColorProfile: c.profile,
+			})
+			out := h.errStyle.Render("boom")
+
+			if !strings.Contains(out, "boom") {
+				t.Fatalf("Expected rendered text to be kept, got %q", out)
+			}
+			if c.want != "" && !strings.Contains(out, c.want) {
+				t.Errorf("Expected %q in output, got %q", c.want, out)
+			}
+			for _, seq := range c.deny {
+				if strings.Contains(out, seq) {
+					t.Errorf("Unexpected %q in %s output: %q", seq, c.profile, out)
+				}
+			}
+			if lipgloss.ColorProfile() != previous {
+				t.Errorf("Expected the global lipgloss profile to stay %v, got %v", previous, lipgloss.ColorProfile())
+			}
+		})
+	}
+}
+
+func TestUnknownColorProfileKeepsDetected(t *testing.T) {
+	for _, name := range []string{"", "bogus"} {
+		if profileRenderer(name) != lipgloss.DefaultRenderer() {
+			t.Errorf("Expected %q to keep the default renderer with the detected profile", name)
+		}
+	}
+}
@@ -0,0 +1,133 @@
+package devtui
+
+import (
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// envHandler is an edit field completing from a fixed list of environments
+type envHandler struct {
+	*TestEditableHandler
+	envs []string
+}
+
+func (e *envHandler) Complete(prefix string) []string {
+	var matches []string
+	for _, env := range e.envs {
+		if strings.HasPrefix(env, prefix) {
+			matches = append(matches, env)
+		}
+	}
+	return matches
+}
+
+func setupCompleterTest(t *testing.T, value string, envs ...string) (*DevTUI, *field) {
+	t.Helper()
+	h := DefaultTUIForTest()
+	h.viewport.Width = 80
+	h.ShowKeyHints = true
+	tab := h.NewTabSection("Deploy", "Completer test")
+	h.AddHandler(&envHandler{NewTestEditableHandler("Env", value), envs}, 0, "", tab)
+	h.activeTab = tab.(*tabSection).index
+	f := tab.(*tabSection).fieldHandlers[0]
+	h.handleKeyboard(tea.KeyMsg{Type: tea.KeyEnter})
+	f.setCursorAtEnd()
+	return h, f
+}
+
+func TestCompleterInsertsCommonPrefixThenCycles(t *testing.T) {
+	h, f := setupCompleterTest(t, "p", "prod", "preview", "staging")
+
+	pressTab(h)
+	if f.tempEditValue != "pr" || f.cursor != 2 {
+		t.Fatalf("Expected Tab to insert the common prefix \"pr\", got %q cursor %d", f.tempEditValue, f.cursor)
+	}
+	if hints := h.keyHints(); !strings.Contains(hints, "Tab: prod preview") {
+		t.Errorf("Expected the candidates in the hint line, got %q", hints)
+	}
+
+	pressTab(h)
+	if f.tempEditValue != "prod" {
+		t.Errorf("Expected the first candidate, got %q", f.tempEditValue)
+	}
+	if hints := h.keyHints(); !strings.Contains(hints, "Tab: preview") || strings.Contains(hints, "prod") {
+		t.Errorf("Expected only the remaining candidate in the hint line, got %q", hints)
+	}
+	pressTab(h)
+	if f.tempEditValue != "preview" {
+		t.Errorf("Expected the second candidate, got %q", f.tempEditValue)
+	}
+	pressTab(h)
+	if f.tempEditValue != "prod" {
+		t.Errorf("Expected cycling back to the first candidate, got %q", f.tempEditValue)
+	}
+
+	h.handleKeyboard(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("x")})
+	if f.completion != nil {
+		t.Error("Expected typing to end the cycle")
+	}
+}
+
+func TestCompleterOnPrefixWithoutLongerCommonPrefix(t *testing.T) {
+	h, f := setupCompleterTest(t, "pr", "prod", "preview")
+
+	pressTab(h)
+	if f.tempEditValue != "prod" {
+		t.Errorf("Expected Tab on %q to start cycling, got %q", "pr", f.tempEditValue)
+	}
+}
+
+func TestCompleterNoMatches(t *testing.T) {
+	h, f := setupCompleterTest(t, "qa", "prod", "preview")
+
+	pressTab(h)
+	if f.tempEditValue != "qa" || f.completion != nil || !h.editModeActivated {
+		t.Errorf("Expected Tab without matches to change nothing, got %q", f.tempEditValue)
+	}
+}
+
+func TestCompleterIsRuneSafe(t *testing.T) {
+	h, f := setupCompleterTest(t, "ñ", "ñandú", "ñandúes")
+
+	pressTab(h)
+	if f.tempEditValue != "ñandú" || f.cursor != len([]rune("ñandú")) {
+		t.Errorf("Expected the multibyte common prefix with a rune cursor, got %q cursor %d", f.tempEditValue, f.cursor)
+	}
+}
+
+// limitedEnvHandler is an envHandler with a MaxLength() limit
+type limitedEnvHandler struct {
+	envHandler
+	maxLen int
+}
+
+func (l *limitedEnvHandler) MaxLength() int { return l.maxLen }
+
+func TestCompleterHonoursMaxLength(t *testing.T) {
+	h := DefaultTUIForTest()
+	h.viewport.Width = 80
+	tab := h.NewTabSection("Deploy", "Completer limit test")
+	h.AddHandler(&limitedEnvHandler{envHandler{NewTestEditableHandler("Env", "p"), []string{"production"}}, 4}, 0, "", tab)
+	h.activeTab = tab.(*tabSection).index
+	f := tab.(*tabSection).fieldHandlers[0]
+	h.handleKeyboard(tea.KeyMsg{Type: tea.KeyEnter})
+	f.setCursorAtEnd()
+
+	pressTab(h)
+	if f.tempEditValue != "prod" || f.cursor != 4 {
+		t.Errorf("Expected the candidate cut to MaxLength 4, got %q cursor %d", f.tempEditValue, f.cursor)
+	}
+}
+
+func TestCompleterHonoursFooterWidth(t *testing.T) {
+	long := "p" + strings.Repeat("x", 200)
+	h, f := setupCompleterTest(t, "p", long)
+
+	pressTab(h)
+	_, availableTextWidth := h.calculateInputWidths(f.handler.Label())
+	if got := len([]rune(f.tempEditValue)); got >= availableTextWidth || !strings.HasPrefix(long, f.tempEditValue) {
+		t.Errorf("Expected the candidate cut below the available width %d, got %d runes", availableTextWidth, got)
+	}
+}
@@ -0,0 +1,92 @@
+package devtui
+
+import "strings"
+
+// Tab completion in edit mode, fed by Complete(prefix) (Completer) or by FileSystem()
+// (HandlerFilePicker). A single match is completed, several matches first complete their
+// common prefix and then cycle one by one on each repeated Tab. Any other key ends the cycle.
+
+// tabCompletion holds the candidates cycled by repeated Tab
+type tabCompletion struct {
+	candidates []string
+	index      int // -1 until the first candidate is selected
+}
+
+// remaining returns the candidates after the selected one, in cycling order
+func (c *tabCompletion) remaining() []string {
+	var rest []string
+	for i := 1; i < len(c.candidates); i++ {
+		if c.index < 0 {
+			rest = c.candidates
+			break
+		}
+		rest = append(rest, c.candidates[(c.index+i)%len(c.candidates)])
+	}
+	return rest
+}
+
+// completes reports whether Tab completes the value in this field
+func (f *field) completes() bool {
+	return f.handler != nil && (f.handler.completeFunc != nil || f.handler.fileSystemFunc != nil)
+}
+
+// completionCandidates asks the handler for the values matching the typed text
+func (f *field) completionCandidates() []string {
+	if f.handler.completeFunc != nil {
+		return f.handler.completeFunc(f.tempEditValue)
+	}
+	reader := f.handler.fileSystemFunc()
+	if reader == nil {
+		reader = osDirReader{}
+	}
+	return pathCandidates(reader, f.tempEditValue)
+}
+
+// complete handles Tab on a completing field. Returns false when there is nothing to complete.
+// Completed values are cut to availableTextWidth and MaxLength(), same as typed text.
+func (f *field) complete(availableTextWidth int) bool {
+	if f.completion != nil { // repeated Tab: next candidate
+		f.completion.index = (f.completion.index + 1) % len(f.completion.candidates)
+		f.setCompletedValue(f.completion.candidates[f.completion.index], availableTextWidth)
+		return true
+	}
+
+	candidates := f.completionCandidates()
+	switch len(candidates) {
+	case 0:
+		return false
+	case 1:
+		f.setCompletedValue(candidates[0], availableTextWidth)
+		return true
+	}
+	f.completion = &tabCompletion{candidates: candidates, index: -1}
+	if prefix := commonPrefix(candidates); len(prefix) > len(f.tempEditValue) && strings.HasPrefix(prefix, f.tempEditValue) {
+		f.setCompletedValue(prefix, availableTextWidth)
+		return true
+	}
+	return f.complete(availableTextWidth)
+}
+
+// setCompletedValue replaces the value, undoable, cursor at the end. The value is cut
+// to what still fits the footer width and MaxLength().
+func (f *field) setCompletedValue(value string, availableTextWidth int) {
+	runes := []rune(value)
+	if room := max(f.pasteRoom(0, availableTextWidth), 0); len(runes) > room {
+		runes = runes[:room]
+	}
+	f.snapshotEdit()
+	f.tempEditValue = string(runes)
+	f.cursor = len(runes)
+	f.notifyEdit()
+}
+
+// commonPrefix returns the longest prefix (in whole runes) shared by all values
+func commonPrefix(values []string) string {
+	prefix := []rune(values[0])
+	for _, v := range values[1:] {
+		for !strings.HasPrefix(v, string(prefix)) {
+			prefix = prefix[:len(prefix)-1]
+		}
+	}
+	return string(prefix)
+}
@@ -0,0 +1,44 @@
+package devtui
+
+import (
+	"strings"
+
+	. "github.com/cdvelop/tinystring"
+)
+
+// Inner scrolling for long HandlerDisplay content.
+// A display handler implementing ContentHeight() int shows at most that many lines of its
+// Content() while focused; Up/Down scroll inside the content instead of the whole tab.
+
+// innerScrollHeight returns the visible content lines for inner scrolling, 0 when disabled
+func (f *field) innerScrollHeight() int {
+	if f.handler == nil || f.handler.contentHeightFunc == nil || !f.hasContentMethod() {
+		return 0
+	}
+	return max(f.handler.contentHeightFunc(), 0)
+}
+
+// scrollContent moves the inner content offset by delta lines.
+// Returns false when the field has no inner scrolling (caller scrolls the viewport).
+func (f *field) scrollContent(delta int) bool {
+	height := f.innerScrollHeight()
+	if height == 0 {
+		return false
+	}
+	total := len(strings.Split(f.getDisplayContent(), "\n"))
+	f.contentOffset = min(max(f.contentOffset+delta, 0), max(total-height, 0))
+	return true
+}
+
+// contentWindow returns the visible lines of content plus a position indicator
+// ("lines 11-20/45") when inner scrolling applies; otherwise content unchanged.
+func (f *field) contentWindow(content string) (visible string, indicator string) {
+	height := f.innerScrollHeight()
+	lines := strings.Split(content, "\n")
+	if height == 0 || len(lines) <= height {
+		return content, ""
+	}
+	start := min(f.contentOffset, len(lines)-height)
+	end := start + height
+	return strings.Join(lines[start:end], "\n"), Fmt("↑↓ lines %d-%d/%d", start+1, end, len(lines))
+}
@@ -0,0 +1,71 @@
+package devtui
+
+import (
+	"strings"
+	"testing"
+
+	. "github.com/cdvelop/tinystring"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/x/ansi"
+)
+
+// itemListHandler is a display handler with a long list and a fixed summary line
+type itemListHandler struct{ items int }
+
+func (l *itemListHandler) Name() string { return "Items" }
+func (l *itemListHandler) Content() string {
+	lines := make([]string, l.items)
+	for i := range lines {
+		lines[i] = Fmt("item %d", i+1)
+	}
+	return strings.Join(lines, "\n")
+}
+func (l *itemListHandler) ContentFooter() string { return Fmt("Total: %d items", l.items) }
+
+func TestContentFooterStaysVisibleWhileScrolling(t *testing.T) {
+	h := DefaultTUIForTest()
+	tab := h.NewTabSection("List", "Content footer test")
+	h.AddHandler(&itemListHandler{items: 42}, 0, "", tab)
+	h.activeTab = tab.(*tabSection).index
+	h.Update(tea.WindowSizeMsg{Width: 60, Height: 12})
+
+	for _, scroll := range []func(){
+		func() { h.viewport.GotoTop() },
+		func() { h.viewport.PageDown() },
+		func() { h.viewport.GotoBottom() },
+	} {
+		h.updateViewport()
+		scroll()
+		frame := strings.Split(ansi.Strip(h.frameView()), "\n")
+
+		if len(frame) != 12 {
+			t.Fatalf("Expected the frame to fill the 12-line window, got %d lines", len(frame))
+		}
+		// Summary right above the footer bar, outside the scrolling viewport
+		if summary := frame[len(frame)-2]; !strings.Contains(summary, "Total: 42 items") {
+			t.Errorf("Expected the summary above the footer bar at offset %d, got %q", h.viewport.YOffset, summary)
+		}
+		if strings.Contains(h.viewport.View(), "Total: 42 items") {
+			t.Error("Expected the summary outside the scrolling content")
+		}
+	}
+	if h.viewport.YOffset == 0 {
+		t.Error("Expected the content to be scrolled")
+	}
+}
+
+func TestContentFooterOnlyForFocusedDisplay(t *testing.T) {
+	h := DefaultTUIForTest()
+	tab := h.NewTabSection("List", "Content footer test")
+	h.AddHandler(NewTestEditableHandler("Port", "8080"), 0, "", tab)
+	h.AddHandler(&itemListHandler{items: 3}, 0, "", tab)
+	h.activeTab = tab.(*tabSection).index
+
+	if summary := h.contentFooter(); summary != "" {
+		t.Errorf("Expected no summary while an edit field is focused, got %q", summary)
+	}
+	tab.(*tabSection).indexActiveEditField = 1
+	if summary := h.contentFooter(); summary != "Total: 3 items" {
+		t.Errorf("Expected the display summary when focused, got %q", summary)
+	}
+}
@@ -0,0 +1,62 @@
+package devtui
+
+import (
+	"strings"
+	"testing"
+
+	. "github.com/cdvelop/tinystring"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// fileViewHandler is a display handler with long content scrolled in a 5-line region
+type fileViewHandler struct{}
+
+func (f *fileViewHandler) Name() string { return "FileView" }
+func (f *fileViewHandler) Content() string {
+	lines := make([]string, 40)
+	for i := range lines {
+		lines[i] = Fmt("file line %d", i+1)
+	}
+	return strings.Join(lines, "\n")
+}
+func (f *fileViewHandler) ContentHeight() int { return 5 }
+
+func TestInnerScrollForLongDisplayContent(t *testing.T) {
+	h := DefaultTUIForTest()
+	h.viewport.Width = 80
+	h.viewport.Height = 20
+
+	tab := h.NewTabSection("Files", "Inner scroll")
+	h.AddHandler(&fileViewHandler{}, 0, "", tab)
+	h.AddHandler(NewTestEditableHandler("Sibling", "value"), 0, "", tab)
+	ts := tab.(*tabSection)
+	h.activeTab = ts.index
+	h.updateViewport()
+
+	view := h.ContentView()
+	if !strings.Contains(view, "file line 5") || strings.Contains(view, "file line 6") {
+		t.Fatalf("Expected only the first 5 lines visible, got %q", view)
+	}
+
+	yOffset := h.viewport.YOffset
+	h.handleKeyboard(tea.KeyMsg{Type: tea.KeyDown})
+	h.handleKeyboard(tea.KeyMsg{Type: tea.KeyDown})
+
+	field := ts.fieldHandlers[0]
+	if field.contentOffset != 2 {
+		t.Errorf("Expected inner offset 2, got %d", field.contentOffset)
+	}
+	if ts.indexActiveEditField != 0 || h.viewport.YOffset != yOffset {
+		t.Errorf("Sibling fields and viewport must not move: field=%d yOffset=%d", ts.indexActiveEditField, h.viewport.YOffset)
+	}
+
+	view = h.ContentView()
+	if !strings.Contains(view, "file line 3") || strings.Contains(view, "file line 2\n") || !strings.Contains(view, "lines 3-7/40") {
+		t.Errorf("Expected lines 3-7 visible with indicator, got %q", view)
+	}
+
+	h.handleKeyboard(tea.KeyMsg{Type: tea.KeyUp})
+	if field.contentOffset != 1 {
+		t.Errorf("Expected Up to scroll back to offset 1, got %d", field.contentOffset)
+	}
+}
@@ -0,0 +1,69 @@
+package devtui
+
+import (
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestCopyTabContentIsPlainText(t *testing.T) {
+	h := DefaultTUIForTest()
+	var clipboard string
+	h.clipboard = func(text string) error {
+		clipboard = text
+		return nil
+	}
+
+	tab := h.NewTabSection("Logs", "Copy test")
+	log := h.AddLogger("Writer", false, "#ff0000", tab)
+	log("first message")
+	log("error: second message")
+	log("third message")
+
+	got, err := h.CopyTabContent("Logs")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	for _, want := range []string{"first message", "error: second message", "third message"} {
+		if !strings.Contains(got, want) {
+			t.Errorf("Expected copied content to contain %q, got %q", want, got)
+		}
+	}
+	if strings.Contains(got, "\x1b[") {
+		t.Errorf("Copied content should not contain ANSI sequences, got %q", got)
+	}
+	if len(strings.Split(got, "\n")) != 3 {
+		t.Errorf("Expected one line per message, got %q", got)
+	}
+	if clipboard != got {
+		t.Errorf("Expected clipboard to receive copied text, got %q", clipboard)
+	}
+}
+
+func TestCopyTabContentUnknownTab(t *testing.T) {
+	h := DefaultTUIForTest()
+	if _, err := h.CopyTabContent("Missing"); err == nil {
+		t.Error("Expected error for unknown tab")
+	}
+}
+
+func TestShiftYCopiesActiveTab(t *testing.T) {
+	h := DefaultTUIForTest()
+	var clipboard string
+	h.clipboard = func(text string) error {
+		clipboard = text
+		return nil
+	}
+
+	tab := h.NewTabSection("Logs", "Copy test")
+	h.activeTab = tab.(*tabSection).index
+	h.AddLogger("Writer", false, "", tab)("copy me")
+
+	h.handleKeyboard(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'Y'}})
+
+	if !strings.Contains(clipboard, "copy me") {
+		t.Errorf("Expected Shift+Y to copy active tab, got %q", clipboard)
+	}
+}
@@ -0,0 +1,58 @@
+package devtui
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// defaultValueHandler is an edit handler that provides DefaultValue()
+type defaultValueHandler struct {
+	*TestEditableHandler
+	def string
+}
+
+func (h *defaultValueHandler) DefaultValue() string { return h.def }
+
+func TestResetToDefaultValue(t *testing.T) {
+	h := DefaultTUIForTest()
+	tab := h.NewTabSection("Test Tab", "Test description")
+	handler := &defaultValueHandler{TestEditableHandler: NewTestEditableHandler("Port", "8080"), def: "8080"}
+	h.AddHandler(handler, 0, "", tab)
+	h.viewport.Width = 80
+
+	h.activeTab = GetFirstTestTabIndex()
+	h.handleKeyboard(tea.KeyMsg{Type: tea.KeyEnter}) // enter edit mode
+	field := h.TabSections[h.activeTab].fieldHandlers[0]
+
+	// Mess up the field
+	field.tempEditValue = ""
+	field.cursor = 0
+	h.handleKeyboard(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("oops")})
+	if field.tempEditValue != "oops" {
+		t.Fatalf("Expected tempEditValue 'oops', got '%s'", field.tempEditValue)
+	}
+
+	h.handleKeyboard(tea.KeyMsg{Type: tea.KeyCtrlR})
+
+	if field.tempEditValue != "8080" {
+		t.Errorf("Expected default '8080' restored, got '%s'", field.tempEditValue)
+	}
+	if field.cursor != 4 {
+		t.Errorf("Expected cursor at end (4), got %d", field.cursor)
+	}
+	if !h.editModeActivated {
+		t.Error("Expected to remain in edit mode after reset")
+	}
+}
+
+func TestResetWithoutDefaultValueKeepsText(t *testing.T) {
+	h, field := setupTestWithEditableField(t)
+
+	h.handleKeyboard(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("abc")})
+	h.handleKeyboard(tea.KeyMsg{Type: tea.KeyCtrlR})
+
+	if field.tempEditValue != "abc" {
+		t.Errorf("Expected tempEditValue unchanged 'abc', got '%s'", field.tempEditValue)
+	}
+}
@@ -0,0 +1,45 @@
+package devtui
+
+import . "github.com/cdvelop/tinystring"
+
+// terms holds the UI words missing from the tinystring dictionary (D), translated with
+// Translate like D terms. Language order: EN, ES, ZH, HI, AR, PT, FR, DE, RU
+var terms = struct {
+	Clear    LocStr // "clear"
+	Close    LocStr // "close"
+	Copy     LocStr // "copy"
+	Default  LocStr // "default"
+	Errors   LocStr // "errors"
+	Filter   LocStr // "filter"
+	Half     LocStr // "half"
+	Help     LocStr // "help"
+	Messages LocStr // "messages"
+	Output   LocStr // "output"
+	Pause    LocStr // "pause"
+	Redo     LocStr // "redo"
+	Reset    LocStr // "reset"
+	Resume   LocStr // "resume"
+	Scroll   LocStr // "scroll"
+	Undo     LocStr // "undo"
+	Warnings LocStr // "warnings"
+	Without  LocStr // "without"
+}{
+	LocStr{"Clear", "Limpiar", "清除", "साफ़ करें", "مسح", "Limpar", "Effacer", "Leeren", "Очистить"},
+	LocStr{"Close", "Cerrar", "关闭", "बंद करें", "إغلاق", "Fechar", "Fermer", "Schließen", "Закрыть"},
+	LocStr{"Copy", "Copiar", "复制", "कॉपी", "نسخ", "Copiar", "Copier", "Kopieren", "Копировать"},
+	LocStr{"Default", "Predeterminado", "默认", "डिफ़ॉल्ट", "افتراضي", "Padrão", "Par défaut", "Standard", "По умолчанию"},
+	LocStr{"Errors", "Errores", "错误", "त्रुटियाँ", "أخطاء", "Erros", "Erreurs", "Fehler", "Ошибки"},
+	LocStr{"Filter", "Filtrar", "筛选", "फ़िल्टर", "تصفية", "Filtrar", "Filtrer", "Filtern", "Фильтр"},
+	LocStr{"Half", "Media", "半", "आधा", "نصف", "Meia", "Demi", "Halbe", "Пол"},
+	LocStr{"Help", "Ayuda", "帮助", "सहायता", "مساعدة", "Ajuda", "Aide", "Hilfe", "Справка"},
+	LocStr{"Messages", "Mensajes", "消息", "संदेश", "الرسائل", "Mensagens", "Messages", "Nachrichten", "Сообщения"},
+	LocStr{"Output", "Salida", "输出", "आउटपुट", "المخرجات", "Saída", "Sortie", "Ausgabe", "Вывод"},
+	LocStr{"Pause", "Pausar", "暂停", "रोकें", "إيقاف مؤقت", "Pausar", "Pause", "Pausieren", "Пауза"},
+	LocStr{"Redo", "Rehacer", "重做", "फिर से करें", "إعادة", "Refazer", "Rétablir", "Wiederholen", "Повторить"},
+	LocStr{"Reset", "Restablecer", "重置", "रीसेट", "إعادة تعيين", "Redefinir", "Réinitialiser", "Zurücksetzen", "Сбросить"},
+	LocStr{"Resume", "Reanudar", "继续", "फिर शुरू करें", "استئناف", "Retomar", "Reprendre", "Fortsetzen", "Продолжить"},
+	LocStr{"Scroll", "Desplazar", "滚动", "स्क्रॉल", "تمرير", "Rolar", "Défiler", "Blättern", "Прокрутить"},
+	LocStr{"Undo", "Deshacer", "撤销", "पूर्ववत करें", "تراجع", "Desfazer", "Annuler", "Rückgängig", "Отменить"},
+	LocStr{"Warnings", "Advertencias", "警告", "चेतावनियाँ", "تحذيرات", "Avisos", "Avertissements", "Warnungen", "Предупреждения"},
+	LocStr{"Without", "Sin", "无", "बिना", "بدون", "Sem", "Sans", "Ohne", "Без"},
+}
@@ -0,0 +1,76 @@
+package devtui
+
+// editHistoryLimit bounds the undo stack to avoid unbounded growth on long edits
+const editHistoryLimit = 100
+
+// editSnapshot captures the editable state of a field at a point in time
+type editSnapshot struct {
+	value  string
+	cursor int
+}
+
+// editHistory keeps undo/redo stacks for the current edit session of a field
+type editHistory struct {
+	undo []editSnapshot
+	redo []editSnapshot
+}
+
+// push records a snapshot taken before a mutation and invalidates the redo stack
+func (eh *editHistory) push(s editSnapshot) {
+	eh.undo = append(eh.undo, s)
+	if len(eh.undo) > editHistoryLimit {
+		eh.undo = eh.undo[len(eh.undo)-editHistoryLimit:]
+	}
+	eh.redo = eh.redo[:0]
+}
+
+// undoFrom returns the previous snapshot, saving current for redo
+func (eh *editHistory) undoFrom(current editSnapshot) (editSnapshot, bool) {
+	if len(eh.undo) == 0 {
+		return current, false
+	}
+	prev := eh.undo[len(eh.undo)-1]
+	eh.undo = eh.undo[:len(eh.undo)-1]
+	eh.redo = append(eh.redo, current)
+	return prev, true
+}
+
+// redoFrom returns the next snapshot, saving current for undo
+func (eh *editHistory) redoFrom(current editSnapshot) (editSnapshot, bool) {
+	if len(eh.redo) == 0 {
+		return current, false
+	}
+	next := eh.redo[len(eh.redo)-1]
+	eh.redo = eh.redo[:len(eh.redo)-1]
+	eh.undo = append(eh.undo, current)
+	return next, true
+}
+
+// reset clears both stacks (called when an edit session starts or ends)
+func (eh *editHistory) reset() {
+	eh.undo = nil
+	eh.redo = nil
+}
+
+// snapshotEdit records the field state before a mutation
+func (f *field) snapshotEdit() {
+	f.history.push(editSnapshot{value: f.tempEditValue, cursor: f.cursor})
+}
+
+// undoEdit restores the previous edit state; returns false if there is nothing to undo
+func (f *field) undoEdit() bool {
+	prev, ok := f.history.undoFrom(editSnapshot{value: f.tempEditValue, cursor: f.cursor})
+	if ok {
+		f.tempEditValue, f.cursor = prev.value, prev.cursor
+	}
+	return ok
+}
+
+// redoEdit re-applies an undone edit; returns false if there is nothing to redo
+func (f *field) redoEdit() bool {
+	next, ok := f.history.redoFrom(editSnapshot{value: f.tempEditValue, cursor: f.cursor})
+	if ok {
+		f.tempEditValue, f.cursor = next.value, next.cursor
+	}
+	return ok
+}
@@ -0,0 +1,95 @@
+package devtui
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestUndoRedoInEditMode(t *testing.T) {
+	h, field := setupTestWithEditableField(t)
+
+	h.handleKeyboard(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("ab")})
+	h.handleKeyboard(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("c")})
+	h.handleKeyboard(tea.KeyMsg{Type: tea.KeyBackspace})
+	if field.tempEditValue != "ab" {
+		t.Fatalf("Expected 'ab' after backspace, got '%s'", field.tempEditValue)
+	}
+
+	h.handleKeyboard(tea.KeyMsg{Type: tea.KeyCtrlZ})
+	if field.tempEditValue != "abc" || field.cursor != 3 {
+		t.Errorf("Undo should restore deleted char: got '%s' cursor %d", field.tempEditValue, field.cursor)
+	}
+
+	h.handleKeyboard(tea.KeyMsg{Type: tea.KeyCtrlZ})
+	if field.tempEditValue != "ab" {
+		t.Errorf("Second undo should remove 'c': got '%s'", field.tempEditValue)
+	}
+
+	h.handleKeyboard(tea.KeyMsg{Type: tea.KeyCtrlY})
+	if field.tempEditValue != "abc" {
+		t.Errorf("Redo should re-apply 'c': got '%s'", field.tempEditValue)
+	}
+
+	// A new edit invalidates the redo stack
+	h.handleKeyboard(tea.KeyMsg{Type: tea.KeySpace})
+	h.handleKeyboard(tea.KeyMsg{Type: tea.KeyCtrlY})
+	if field.tempEditValue != "abc " {
+		t.Errorf("Redo after new edit should do nothing: got '%s'", field.tempEditValue)
+	}
+}
+
+func TestUndoRevertsStepwise(t *testing.T) {
+	h, field := setupTestWithEditableField(t)
+
+	for _, r := range "port" {
+		h.handleKeyboard(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{r}})
+	}
+	h.handleKeyboard(tea.KeyMsg{Type: tea.KeyBackspace})
+	h.handleKeyboard(tea.KeyMsg{Type: tea.KeyBackspace})
+	if field.tempEditValue != "po" {
+		t.Fatalf("Expected 'po' after two backspaces, got '%s'", field.tempEditValue)
+	}
+
+	// Each Ctrl+Z reverts exactly one keystroke, deletions first
+	for _, want := range []string{"por", "port", "por", "po", "p", ""} {
+		h.handleKeyboard(tea.KeyMsg{Type: tea.KeyCtrlZ})
+		if field.tempEditValue != want {
+			t.Fatalf("Expected '%s' after undo, got '%s'", want, field.tempEditValue)
+		}
+		if field.cursor != len([]rune(want)) {
+			t.Errorf("Expected cursor %d after undo to '%s', got %d", len([]rune(want)), want, field.cursor)
+		}
+	}
+
+	// Nothing left to undo
+	h.handleKeyboard(tea.KeyMsg{Type: tea.KeyCtrlZ})
+	if field.tempEditValue != "" {
+		t.Errorf("Expected undo on empty history to keep '', got '%s'", field.tempEditValue)
+	}
+}
+
+func TestUndoHistoryResetsOnExit(t *testing.T) {
+	h, field := setupTestWithEditableField(t)
+
+	h.handleKeyboard(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("x")})
+	h.handleKeyboard(tea.KeyMsg{Type: tea.KeyEsc})
+
+	if len(field.history.undo) != 0 || len(field.history.redo) != 0 {
+		t.Errorf("Expected empty history after leaving edit mode, got undo=%d redo=%d",
+			len(field.history.undo), len(field.history.redo))
+	}
+}
+
+func TestEditHistoryIsBounded(t *testing.T) {
+	var eh editHistory
+	for i := 0; i < editHistoryLimit+50; i++ {
+		eh.push(editSnapshot{cursor: i})
+	}
+	if len(eh.undo) != editHistoryLimit {
+		t.Errorf("Expected %d entries, got %d", editHistoryLimit, len(eh.undo))
+	}
+	if eh.undo[0].cursor != 50 {
+		t.Errorf("Expected oldest entries dropped first, got first cursor %d", eh.undo[0].cursor)
+	}
+}
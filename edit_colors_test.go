@@ -0,0 +1,42 @@
+package devtui
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/muesli/termenv"
+)
+
+func TestEditColorsAppliedInEditMode(t *testing.T) {
+	previous := lipgloss.ColorProfile()
+	lipgloss.SetColorProfile(termenv.TrueColor)
+	defer lipgloss.SetColorProfile(previous)
+
+	h, field := setupTestWithEditableField(t)
+	h.EditBackground = "#123456" // rgb(18,52,86)
+	h.EditForeground = "#ABCDEF" // rgb(171,205,239)
+	field.tempEditValue = "value"
+
+	const editBackground = "48;2;18;52;86"
+	const editForeground = "38;2;171;205;239"
+
+	out := h.renderFooterInput()
+	if !strings.Contains(out, editBackground) || !strings.Contains(out, editForeground) {
+		t.Errorf("Expected custom edit colors in edit mode, got %q", out)
+	}
+
+	h.editModeActivated = false
+	out = h.renderFooterInput()
+	if strings.Contains(out, editBackground) || strings.Contains(out, editForeground) {
+		t.Errorf("Expected custom edit colors only in edit mode, got %q", out)
+	}
+}
+
+func TestEditColorsDefaultToPalette(t *testing.T) {
+	style := newTuiStyle(nil, nil)
+	background, foreground := style.editColors()
+	if background != style.Secondary || foreground != style.Foreground {
+		t.Errorf("Expected Secondary/Foreground defaults, got %q/%q", background, foreground)
+	}
+}
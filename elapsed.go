@@ -0,0 +1,57 @@
+package devtui
+
+import (
+	"context"
+	"time"
+)
+
+// setRunning records whether the async operation runs and since when
+func (s *internalAsyncState) setRunning(running bool, start time.Time) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.isRunning = running
+	s.percent = "" // each operation reports its own progress
+	if running {
+		s.startTime = start
+	}
+}
+
+// setCancel records the cancel func of the running operation (nil once it ended)
+func (s *internalAsyncState) setCancel(cancel context.CancelFunc) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.cancel = cancel
+}
+
+// running reports whether the async operation runs and since when
+func (s *internalAsyncState) running() (bool, time.Time) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.isRunning, s.startTime
+}
+
+// now returns the current time from the TUI clock (TuiConfig.Now), real time otherwise
+func (f *field) now() time.Time {
+	if f.parentTab != nil && f.parentTab.tui != nil && f.parentTab.tui.now != nil {
+		return f.parentTab.tui.now()
+	}
+	return time.Now()
+}
+
+// elapsedText returns the elapsed time of the running operation against the handler
+// timeout, e.g. "12s / 45s" ("12s" without timeout), or "" when nothing runs.
+// The footer redraws on every tick, so it counts up each second.
+func (f *field) elapsedText() string {
+	if f == nil || f.handler == nil || f.asyncState == nil {
+		return ""
+	}
+	running, start := f.asyncState.running()
+	if !running {
+		return ""
+	}
+	elapsed := max(f.now().Sub(start), 0).Truncate(time.Second)
+	if timeout := f.handler.Timeout(); timeout > 0 {
+		return elapsed.String() + " / " + timeout.Truncate(time.Second).String()
+	}
+	return elapsed.String()
+}
@@ -0,0 +1,93 @@
+package devtui
+
+import (
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/charmbracelet/x/ansi"
+)
+
+// blockingExecHandler blocks in Execute until release is closed
+type blockingExecHandler struct {
+	release chan struct{}
+}
+
+func (h *blockingExecHandler) Name() string  { return "Deploy" }
+func (h *blockingExecHandler) Label() string { return "Deploy" }
+func (h *blockingExecHandler) Execute(progress chan<- string) {
+	progress <- "uploading" // handler progress keeps going to the log
+	<-h.release
+}
+
+func setupElapsedTest(t *testing.T, timeout time.Duration) (*DevTUI, *field, *blockingExecHandler, func(time.Duration)) {
+	t.Helper()
+	var mu sync.Mutex
+	now := time.Date(2024, 5, 1, 9, 0, 0, 0, time.Local)
+	h := NewTUI(&TuiConfig{
+		AppName:  "Elapsed",
+		ExitChan: make(chan bool),
+		Logger:   func(...any) {},
+		Now: func() time.Time {
+			mu.Lock()
+			defer mu.Unlock()
+			return now
+		},
+	})
+	h.viewport.Width = 80
+	h.viewport.Height = 10
+	handler := &blockingExecHandler{release: make(chan struct{})}
+	tab := h.NewTabSection("Ops", "Elapsed test")
+	h.AddHandler(handler, timeout, "", tab)
+	ts := tab.(*tabSection)
+	h.activeTab = ts.index
+
+	advance := func(d time.Duration) {
+		mu.Lock()
+		now = now.Add(d)
+		mu.Unlock()
+	}
+	return h, ts.fieldHandlers[0], handler, advance
+}
+
+func TestElapsedIndicatorShowsTimeout(t *testing.T) {
+	h, f, handler, advance := setupElapsedTest(t, 45*time.Second)
+
+	done := make(chan struct{})
+	go func() {
+		f.executeAsyncChange("")
+		close(done)
+	}()
+	waitFor(t, func() bool { return f.elapsedText() != "" })
+
+	advance(12 * time.Second)
+	if info := ansi.Strip(h.renderFooterInfo()); !strings.Contains(info, "12s / 45s") {
+		t.Errorf("Expected elapsed indicator '12s / 45s' in footer, got %q", info)
+	}
+
+	close(handler.release)
+	<-done
+	if info := ansi.Strip(h.renderFooterInfo()); strings.Contains(info, "45s") {
+		t.Errorf("Expected elapsed indicator cleared after completion, got %q", info)
+	}
+}
+
+func TestElapsedIndicatorWithoutTimeout(t *testing.T) {
+	h, f, handler, advance := setupElapsedTest(t, 0)
+
+	done := make(chan struct{})
+	go func() {
+		f.executeAsyncChange("")
+		close(done)
+	}()
+	waitFor(t, func() bool { return f.elapsedText() != "" })
+
+	advance(3*time.Second + 400*time.Millisecond)
+	if info := ansi.Strip(h.renderFooterInfo()); !strings.Contains(info, "3s") || strings.Contains(info, "/") {
+		t.Errorf("Expected elapsed seconds only without timeout, got %q", info)
+	}
+
+	close(handler.release)
+	<-done
+}
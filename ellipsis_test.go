@@ -0,0 +1,31 @@
+package devtui
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestEllipsisStringOnTruncatedLabel(t *testing.T) {
+	h := NewTUI(&TuiConfig{ExitChan: make(chan bool), EllipsisString: "..."})
+	h.SetTestMode(true)
+	h.viewport.Width = 80
+
+	tab := h.NewTabSection("Config", "Ellipsis")
+	h.AddHandler(NewTestEditableHandler("A very long label that cannot fit", "value"), 0, "", tab)
+	h.activeTab = tab.(*tabSection).index
+
+	footer := h.footerView()
+	if !strings.Contains(footer, "A very long la...") {
+		t.Errorf("Expected truncated label ending with '...', got %q", footer)
+	}
+}
+
+func TestEllipsisDefault(t *testing.T) {
+	h := DefaultTUIForTest()
+	if got := h.truncate("abcdefghij", 5); got != "abcd…" {
+		t.Errorf("Expected 'abcd…', got %q", got)
+	}
+	if got := h.truncate("short", 5); got != "short" {
+		t.Errorf("Expected untouched text, got %q", got)
+	}
+}
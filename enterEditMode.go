@@ -0,0 +1,37 @@
+package devtui
+
+import (
+	"errors"
+
+	. "github.com/cdvelop/tinystring"
+)
+
+// EnterEditMode focuses the field fieldIndex of the tab tabIndex and opens it in edit
+// mode with its current value and the cursor at the end, as if the user navigated to it
+// and pressed Enter. Returns an error for out of range indices or non-editable fields.
+//
+// Example:
+//
+//	tui.EnterEditMode(1, 0) // edit the first field of the second tab
+func (h *DevTUI) EnterEditMode(tabIndex, fieldIndex int) error {
+	if tabIndex < 0 || tabIndex >= len(h.TabSections) {
+		return errors.New(Fmt("tab index out of range: %d", tabIndex))
+	}
+	ts := h.TabSections[tabIndex]
+	if fieldIndex < 0 || fieldIndex >= len(ts.fieldHandlers) {
+		return errors.New(Fmt("field index out of range: %d", fieldIndex))
+	}
+	f := ts.fieldHandlers[fieldIndex]
+	if !f.editable() {
+		return errors.New(Fmt("field not editable: %d", fieldIndex))
+	}
+
+	h.switchTab(tabIndex)
+	ts.indexActiveEditField = fieldIndex
+	h.syncFocus()
+
+	f.tempEditValue = f.Value()
+	h.editingConfigOpen(true, f, "") // cursor at the end of the value
+	h.updateViewport()
+	return nil
+}
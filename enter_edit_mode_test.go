@@ -0,0 +1,60 @@
+package devtui
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/charmbracelet/x/ansi"
+)
+
+func TestEnterEditModeShowsCursor(t *testing.T) {
+	h := DefaultTUIForTest()
+	h.viewport.Width = 80
+	h.viewport.Height = 10
+	tab := h.NewTabSection("Config", "Enter edit mode test")
+	h.AddHandler(NewTestNonEditableHandler("Deploy", "deploy"), 0, "", tab)
+	h.AddHandler(NewTestEditableHandler("Port", "8080"), 0, "", tab)
+	ts := tab.(*tabSection)
+
+	if err := h.EnterEditMode(ts.index, 1); err != nil {
+		t.Fatalf("EnterEditMode returned error: %v", err)
+	}
+
+	f := ts.fieldHandlers[1]
+	if !h.editModeActivated || h.activeTab != ts.index || ts.indexActiveEditField != 1 {
+		t.Fatalf("Expected edit mode on tab %d field 1, got edit=%v tab=%d field=%d",
+			ts.index, h.editModeActivated, h.activeTab, ts.indexActiveEditField)
+	}
+	if f.tempEditValue != "8080" || f.cursor != 4 {
+		t.Errorf("Expected value 8080 with cursor at 4, got %q at %d", f.tempEditValue, f.cursor)
+	}
+	if footer := ansi.Strip(h.footerView()); !strings.Contains(footer, "8080▋") {
+		t.Errorf("Expected the footer to show the cursor after the value, got %q", footer)
+	}
+}
+
+func TestEnterEditModeErrors(t *testing.T) {
+	h := DefaultTUIForTest()
+	tab := h.NewTabSection("Config", "Enter edit mode test")
+	h.AddHandler(NewTestNonEditableHandler("Deploy", "deploy"), 0, "", tab)
+	ts := tab.(*tabSection)
+
+	cases := []struct {
+		tab, field int
+		want       string
+	}{
+		{len(h.TabSections), 0, "tab index out of range"},
+		{-1, 0, "tab index out of range"},
+		{ts.index, 5, "field index out of range"},
+		{ts.index, 0, "field not editable"},
+	}
+	for _, c := range cases {
+		err := h.EnterEditMode(c.tab, c.field)
+		if err == nil || !strings.Contains(err.Error(), c.want) {
+			t.Errorf("EnterEditMode(%d, %d): expected %q error, got %v", c.tab, c.field, c.want, err)
+		}
+	}
+	if h.editModeActivated {
+		t.Error("Expected edit mode to stay closed after errors")
+	}
+}
@@ -1,27 +1,45 @@
 package example
 
-import "time"
+import (
+	"sync"
+	"time"
+)
 
 type DatabaseHandler struct {
 	ConnectionString string
-	LastAction       string
+	LastAction       string // written by Change; read it with Action() while Change may be running
+
+	mu sync.Mutex
 }
 
 func (h *DatabaseHandler) Name() string  { return "DatabaseConfig" }
 func (h *DatabaseHandler) Label() string { return "Database Connection" }
 func (h *DatabaseHandler) Value() string { return h.ConnectionString }
 
+// Action returns LastAction; safe to call while a shortcut runs Change in the background.
+func (h *DatabaseHandler) Action() string {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return h.LastAction
+}
+
+func (h *DatabaseHandler) setAction(action string) {
+	h.mu.Lock()
+	h.LastAction = action
+	h.mu.Unlock()
+}
+
 func (h *DatabaseHandler) Change(newValue string, progress chan<- string) {
 	switch newValue {
 	case "t":
-		h.LastAction = "test"
+		h.setAction("test")
 		if progress != nil {
 			progress <- "Testing database connection..."
 			time.Sleep(500 * time.Millisecond)
 			progress <- "Connection test completed successfully"
 		}
 	case "b":
-		h.LastAction = "backup"
+		h.setAction("backup")
 		if progress != nil {
 			progress <- "Starting database backup..."
 			time.Sleep(1000 * time.Millisecond)
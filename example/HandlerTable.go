@@ -0,0 +1,13 @@
+package example
+
+type ServicesHandler struct{}
+
+func (h *ServicesHandler) Name() string      { return "Services Status" }
+func (h *ServicesHandler) Headers() []string { return []string{"Service", "Status", "Uptime"} }
+func (h *ServicesHandler) Rows() [][]string {
+	return [][]string{
+		{"api", "running", "2h 30m"},
+		{"database", "running", "5d 4h"},
+		{"worker", "stopped"},
+	}
+}
@@ -26,6 +26,7 @@ func main() {
 	// Dashboard tab with DisplayHandlers (read-only information)
 	dashboard := tui.NewTabSection("Dashboard", "System Overview")
 	tui.AddHandler(&example.StatusHandler{}, 0, "", dashboard)
+	tui.AddHandler(&example.ServicesHandler{}, 0, "", dashboard)
 
 	// Configuration tab with EditHandlers (interactive fields)
 	config := tui.NewTabSection("Config", "System Configuration")
@@ -78,6 +79,7 @@ func main() {
 
 	// Handler Types Summary:
 	// • HandlerDisplay: Name() + Content() - Shows immediate content
+	// • HandlerTable: Name() + Headers() + Rows() - Aligned tabular content
 	// • HandlerEdit: Name() + Label() + Value() + Change() - Interactive fields
 	// • HandlerExecution: Name() + Label() + Execute() - Action buttons
 	// • HandlerInteractive: Name() + Label() + Value() + Change() + WaitingForUser() - Interactive content
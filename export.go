@@ -0,0 +1,99 @@
+package devtui
+
+import (
+	"errors"
+	"os"
+	"strings"
+
+	"github.com/aymanbagabas/go-osc52/v2"
+	. "github.com/cdvelop/tinystring"
+	"github.com/charmbracelet/x/ansi"
+)
+
+// ExportPlain returns the messages of the tab titled tabTitle as plain text
+// (one message per line, no ANSI styling, no viewport wrapping).
+func (h *DevTUI) ExportPlain(tabTitle string) (string, error) {
+	ts := h.findTabByTitle(tabTitle)
+	if ts == nil {
+		return "", errors.New("tab not found: " + tabTitle)
+	}
+
+	ts.mu.RLock()
+	contents := make([]tabContent, len(ts.tabContents))
+	copy(contents, ts.tabContents)
+	ts.mu.RUnlock()
+
+	lines := make([]string, 0, len(contents))
+	for _, msg := range contents {
+		lines = append(lines, h.plainMessage(msg))
+	}
+	return strings.Join(lines, "\n"), nil
+}
+
+// CopyTabContent copies the plain text of the tab titled tabTitle to the
+// system clipboard (OSC 52) and returns the copied text.
+func (h *DevTUI) CopyTabContent(tabTitle string) (string, error) {
+	text, err := h.ExportPlain(tabTitle)
+	if err != nil {
+		return "", err
+	}
+	if h.clipboard != nil {
+		if err := h.clipboard(text); err != nil {
+			return text, err
+		}
+	}
+	return text, nil
+}
+
+// copyActiveTab copies the active tab content (Shift+Y)
+func (h *DevTUI) copyActiveTab() {
+	if h.activeTab >= len(h.TabSections) {
+		return
+	}
+	if _, err := h.CopyTabContent(h.TabSections[h.activeTab].currentTitle()); err != nil && h.Logger != nil {
+		h.Logger("Copy tab content error:", err)
+	}
+}
+
+// plainMessage mirrors formatMessage without styling or wrapping
+func (h *DevTUI) plainMessage(msg tabContent) string {
+	content := msg.Content
+	if msg.repeatCount > 1 {
+		content = Fmt("%s (x%d)", content, msg.repeatCount)
+	}
+
+	if msg.handlerName != "" && h.isReadOnlyHandler(msg.handlerName) {
+		return ansi.Strip(content)
+	}
+
+	var prefix string
+	if h.showTimestamps {
+		prefix = ansi.Strip(h.generateTimestamp(msg.Timestamp)) + " "
+	}
+	if msg.handlerName != "" && !h.hideHandlerNames && !h.isInteractiveHandler(msg.handlerName) {
+		prefix += msg.handlerName + " "
+	}
+	return ansi.Strip(prefix + content)
+}
+
+// findTabByTitle returns the first tab with the given title, falling back to the title
+// given to NewTabSection for tabs renamed with SetTitle; nil if none
+func (h *DevTUI) findTabByTitle(title string) *tabSection {
+	for _, ts := range h.TabSections {
+		if ts.currentTitle() == title {
+			return ts
+		}
+	}
+	for _, ts := range h.TabSections {
+		if ts.originalTitle == title {
+			return ts
+		}
+	}
+	return nil
+}
+
+// writeClipboard sets the terminal clipboard using the OSC 52 escape sequence
+func writeClipboard(text string) error {
+	_, err := osc52.New(text).WriteTo(os.Stderr)
+	return err
+}
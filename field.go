@@ -2,9 +2,13 @@ package devtui
 
 import (
 	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	. "github.com/cdvelop/tinystring"
+	tea "github.com/charmbracelet/bubbletea"
 )
 
 // Internal async state management (not exported)
@@ -13,6 +17,8 @@ type internalAsyncState struct {
 	operationID string
 	cancel      context.CancelFunc
 	startTime   time.Time
+	percent     string       // latest progress percentage of the running operation, e.g. "40%"
+	mu          sync.RWMutex // guards isRunning/startTime/percent/cancel, read by the footer (elapsed, progress)
 }
 
 // Field represents a field in the TUI with a handler-based approach
@@ -28,7 +34,11 @@ type field struct {
 	// UNCHANGED: Existing internal fields
 	tempEditValue string // use for edit
 	index         int
-	cursor        int // cursor position in text value
+	cursor        int            // cursor position in text value
+	history       editHistory    // undo/redo stacks for the current edit session
+	contentOffset int            // first visible Content() line with inner scrolling (ContentHeight)
+	selected      bool           // whole value selected on entering edit mode: typing replaces it (SelectAllOnEdit)
+	completion    *tabCompletion // candidates cycled by repeated Tab (Completer, HandlerFilePicker)
 }
 
 // setTempEditValueForTest permite modificar tempEditValue en tests
@@ -50,6 +60,39 @@ func (ts *tabSection) setFieldHandlers(handlers []*field) {
 // addFields adds one or more field handlers to the section (private)
 func (ts *tabSection) addFields(fields ...*field) {
 	ts.fieldHandlers = append(ts.fieldHandlers, fields...)
+	// Never rest on a non-focusable field
+	ts.indexActiveEditField = ts.cycleFocusable(ts.indexActiveEditField, 1)
+}
+
+// focusable reports whether navigation may land on the field (optional Focusable() false skips it)
+func (f *field) focusable() bool {
+	return f.handler == nil || f.handler.Focusable()
+}
+
+// cycleFocusable returns the first focusable field index starting at from and moving by
+// step (+1/-1), wrapping around. Returns from when no field is focusable.
+func (ts *tabSection) cycleFocusable(from, step int) int {
+	total := len(ts.fieldHandlers)
+	if total == 0 {
+		return from
+	}
+	for i := 0; i < total; i++ {
+		idx := ((from+step*i)%total + total) % total
+		if ts.fieldHandlers[idx].focusable() {
+			return idx
+		}
+	}
+	return from
+}
+
+// nextFocusable returns the first focusable field index >= from without wrapping, -1 if none
+func (ts *tabSection) nextFocusable(from int) int {
+	for idx := max(from, 0); idx < len(ts.fieldHandlers); idx++ {
+		if ts.fieldHandlers[idx].focusable() {
+			return idx
+		}
+	}
+	return -1
 }
 
 func (f *field) Value() string {
@@ -81,7 +124,12 @@ func (f *field) isDisplayOnly() bool {
 	if f.handler == nil {
 		return false
 	}
-	return f.handler.handlerType == handlerTypeDisplay
+	return f.handler.handlerType == handlerTypeDisplay || f.handler.handlerType == handlerTypeTable
+}
+
+// isTableHandler reports whether the field renders HandlerTable rows in the content area
+func (f *field) isTableHandler() bool {
+	return f.handler != nil && f.handler.handlerType == handlerTypeTable
 }
 
 // NUEVO: Detección para execution con footer expandido
@@ -92,6 +140,11 @@ func (f *field) isExecutionHandler() bool {
 	return f.handler.handlerType == handlerTypeExecution
 }
 
+// isToggleHandler reports whether the field is an on/off HandlerToggle
+func (f *field) isToggleHandler() bool {
+	return f.handler != nil && f.handler.handlerType == handlerTypeToggle
+}
+
 // NUEVO: Detección para handlers que usan footer expandido (Display + Execution)
 func (f *field) usesExpandedFooter() bool {
 	return f.isDisplayOnly() || f.isExecutionHandler()
@@ -142,13 +195,13 @@ func (f *field) triggerContentDisplay() {
 		// Create progress callback that follows MessageTracker logic
 
 		// Use helper to safely collect progress messages
-		progressChan, done := f.collectProgressMessages(func(msg string) {
+		progressChan, done := f.collectProgressMessages(context.Background(), func(msg MessageUpdate) {
 			// Process message immediately
-			f.sendMessage(msg)
+			f.sendUpdate(msg)
 		})
 
 		// Execute handler
-		f.handler.Change("", progressChan)
+		f.handler.changeTyped("", progressChan)
 		close(progressChan)
 		<-done
 
@@ -171,6 +224,81 @@ func (f *field) getExpandedFooterLabel() string {
 	return ""
 }
 
+// exceedsMaxLength reports whether a value of length runes would exceed the handler's MaxLength()
+func (f *field) exceedsMaxLength(length int) bool {
+	if f.handler == nil {
+		return false
+	}
+	maxLen := f.handler.MaxLength()
+	return maxLen > 0 && length > maxLen
+}
+
+// placeholderText returns the handler's Placeholder() only while both the value and tempEditValue are empty.
+// It is a rendering hint and never becomes the field value.
+func (f *field) placeholderText() string {
+	if f.handler == nil || f.tempEditValue != "" || f.Value() != "" {
+		return ""
+	}
+	return f.handler.Placeholder()
+}
+
+// notifyEdit sends the current tempEditValue to the handler's optional OnEdit() hook.
+// Called on every keystroke that changes the value, independently of Enter/commit.
+func (f *field) notifyEdit() {
+	if f.handler != nil {
+		f.handler.OnEdit(f.tempEditValue)
+	}
+}
+
+// preserveOnEsc reports whether Esc keeps the uncommitted tempEditValue so the next
+// edit session resumes it (optional PreserveOnEsc() of the handler)
+func (f *field) preserveOnEsc() bool {
+	return f.handler != nil && f.handler.preserveOnEscFunc != nil && f.handler.preserveOnEscFunc()
+}
+
+// selectAllOnEdit reports whether entering edit mode selects the whole value: the
+// handler's optional SelectAllOnEdit() wins over TuiConfig.SelectAllOnEdit
+func (f *field) selectAllOnEdit() bool {
+	if f.handler != nil && f.handler.selectAllFunc != nil {
+		return f.handler.selectAllFunc()
+	}
+	return f.parentTab != nil && f.parentTab.tui != nil && f.parentTab.tui.SelectAllOnEdit
+}
+
+// replaceSelection clears the selected value before the first keystroke replaces it.
+// Any other key just cancels the selection.
+func (f *field) replaceSelection(key tea.KeyType) {
+	if !f.selected {
+		return
+	}
+	f.selected = false
+	switch key {
+	case tea.KeyRunes, tea.KeySpace, tea.KeyBackspace:
+		f.snapshotEdit()
+		f.tempEditValue = ""
+		f.cursor = 0
+		if key == tea.KeyBackspace {
+			f.notifyEdit()
+		}
+	}
+}
+
+// resetToDefault loads the handler's DefaultValue() into tempEditValue.
+// Returns false if the handler does not provide a default value.
+func (f *field) resetToDefault() bool {
+	if f.handler == nil {
+		return false
+	}
+	def, ok := f.handler.DefaultValue()
+	if !ok {
+		return false
+	}
+	f.snapshotEdit()
+	f.tempEditValue = def
+	f.cursor = len([]rune(def))
+	return true
+}
+
 func (f *field) setCursorAtEnd() {
 	// Calculate cursor position based on rune count, not byte count
 	if f.handler != nil {
@@ -203,13 +331,28 @@ func (f *field) getCurrentValue() any {
 // Returns the progress channel (for handler to send to) and done channel (to wait for completion).
 // The caller must close progressChan after handler completes, then wait on <-done.
 // This helper unifies the pattern and ensures 'done' is always closed via defer, preventing panics.
-func (f *field) collectProgressMessages(processMessage func(string)) (progressChan chan string, done chan struct{}) {
-	progressChan = make(chan string, 10)
+// Status updates go to the footer status line while ctx is live, and are cleared once ctx ends
+// or the handler returns.
+func (f *field) collectProgressMessages(ctx context.Context, processMessage func(MessageUpdate)) (progressChan chan MessageUpdate, done chan struct{}) {
+	progressChan = make(chan MessageUpdate, 10)
 	done = make(chan struct{})
 
 	go func() {
 		defer close(done) // Always close done, even with early returns
+		status := &progressStatus{tab: f.parentTab}
+		stop := context.AfterFunc(ctx, status.end) // abandoned attempt: drop its status at once
+		defer func() {
+			stop()
+			status.end() // status only lasts while the operation runs
+		}()
 		for msg := range progressChan {
+			// Status() messages go to the footer status line, details to the log
+			if msg.Status {
+				if ctx.Err() == nil {
+					status.show(msg.Content)
+				}
+				continue
+			}
 			if processMessage != nil {
 				processMessage(msg)
 			}
@@ -222,7 +365,16 @@ func (f *field) collectProgressMessages(processMessage func(string)) (progressCh
 // sendMessage sends a message through parent tab with automatic type detection
 // REFACTORIZADO: Reemplaza sendProgressMessage, sendErrorMessage, sendSuccessMessage
 func (f *field) sendMessage(msgs ...any) {
-	if f.parentTab == nil || f.parentTab.tui == nil || len(msgs) == 0 {
+	if len(msgs) == 0 {
+		return
+	}
+	message, msgType := Translate(msgs...).StringType()
+	f.sendUpdate(MessageUpdate{Content: message, Type: msgType})
+}
+
+// sendUpdate sends a message with its type as given (typed progress keeps its MessageUpdate.Type)
+func (f *field) sendUpdate(msg MessageUpdate) {
+	if f.parentTab == nil || f.parentTab.tui == nil {
 		return
 	}
 
@@ -246,9 +398,24 @@ func (f *field) sendMessage(msgs ...any) {
 		return
 	}
 
-	// Convert and send message with automatic type detection
-	message, msgType := Translate(msgs...).StringType()
-	f.parentTab.tui.sendMessageWithHandler(message, msgType, f.parentTab, handlerName, operationID, handlerColor)
+	f.parentTab.tui.sendUpdateWithHandler(msg, f.parentTab, handlerName, operationID, handlerColor)
+}
+
+// longRunningMessage warns about operations without timeout exceeding TuiConfig.LongRunningWarning
+const longRunningMessage = "operation running long (no timeout configured)"
+
+// warnIfRunningLong schedules longRunningMessage when the handler has no timeout and the
+// operation outlives TuiConfig.LongRunningWarning. The returned func cancels the warning.
+func (f *field) warnIfRunningLong(timeout time.Duration) (stop func()) {
+	if timeout > 0 || f.parentTab == nil || f.parentTab.tui == nil || f.parentTab.tui.LongRunningWarning <= 0 {
+		return func() {}
+	}
+	name, color := f.handler.Name(), f.handler.handlerColor
+	timer := time.AfterFunc(f.parentTab.tui.LongRunningWarning, func() {
+		// No operation ID: the warning stays visible after the result replaces the progress line
+		f.parentTab.tui.sendMessageWithHandler(longRunningMessage, Msg.Warning, f.parentTab, name, "", color)
+	})
+	return func() { timer.Stop() }
 }
 
 // executeAsyncChange executes the handler's Change method asynchronously
@@ -263,19 +430,11 @@ func (f *field) executeAsyncChange(valueToSave any) {
 		return
 	}
 
-	// Create internal context with timeout from handler
-	timeout := f.handler.Timeout()
-	var ctx context.Context
-	var cancel context.CancelFunc
-
-	if timeout > 0 {
-		ctx, cancel = context.WithTimeout(context.Background(), timeout)
-	} else {
-		ctx, cancel = context.WithCancel(context.Background())
-	}
-
-	f.asyncState.cancel = cancel
-	f.asyncState.isRunning = true
+	// Cancelling the operation stops the current attempt and any pending retry
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel() // Clean up context
+	f.asyncState.setCancel(cancel)
+	defer f.asyncState.setCancel(nil)
 
 	// Generate ONE operation ID for the entire async operation OR reuse existing one
 	if f.parentTab != nil && f.parentTab.tui != nil && f.parentTab.tui.id != nil {
@@ -292,28 +451,108 @@ func (f *field) executeAsyncChange(valueToSave any) {
 			f.parentTab.tui.Logger("Warning: Cannot generate operation ID, unixid not initialized")
 		}
 	}
-	f.asyncState.startTime = time.Now()
+	f.asyncState.setRunning(true, f.now())
+	defer f.asyncState.setRunning(false, time.Time{}) // after the result is reported (HasRunningOperations)
+
+	// Soft warning for operations without deadline (TuiConfig.LongRunningWarning)
+	timeout := f.handler.Timeout()
+	stopLongRunningWarning := f.warnIfRunningLong(timeout)
+	defer stopLongRunningWarning()
 
 	// Use the pre-captured value instead of getCurrentValue()
-	currentValue := valueToSave
+	currentValue := valueToSave.(string)
+
+	// RetryPolicy(): failed attempts are retried with backoff, each with the full timeout
+	attempts, backoff := f.retryPolicy()
+	var result string
+	var failed bool
+	var err error
+	for attempt := 1; ; attempt++ {
+		var finished <-chan struct{}
+		result, failed, finished, err = f.runChangeAttempt(ctx, currentValue, timeout)
+		if (err == nil && !failed) || attempt >= attempts || errors.Is(err, context.Canceled) {
+			break
+		}
+		// A timed out Change may still be running: never run two at once on the same handler
+		if !waitAttemptFinished(ctx, finished, timeout) {
+			break
+		}
+		f.sendMessage(retryMessage(attempt+1, attempts))
+		if !sleepContext(ctx, backoff) {
+			err = ctx.Err()
+			break
+		}
+	}
+
+	switch {
+	case errors.Is(err, context.DeadlineExceeded):
+		f.sendMessage(Fmt("Operation timed out after %v", timeout))
+	case err != nil:
+		f.sendMessage("Operation was cancelled")
+	case failed:
+		// The handler already reported the error through progress
+	default:
+		switch f.handler.handlerType {
+		case handlerTypeEdit:
+			// NEW: If handler has Content() method, only refresh display
+			if f.hasContentMethod() {
+				f.parentTab.tui.updateViewport()
+			} else {
+				f.sendMessage(result)
+			}
+		case handlerTypeExecution:
+			// Only send if handler explicitly implements Value()
+			if _, ok := f.handler.origHandler.(interface{ Value() string }); ok {
+				f.sendMessage(result)
+			}
+			// Other handler types: do not send success message
+		}
+	}
+}
+
+// runChangeAttempt runs the handler's Change once, bounded by timeout (0 = no limit).
+// failed reports that the last progress message was an error; err is set when ctx or
+// the timeout ended the attempt before Change returned. finished is closed once Change
+// returns; progress sent by an abandoned attempt is dropped.
+func (f *field) runChangeAttempt(parent context.Context, value string, timeout time.Duration) (result string, failed bool, finished <-chan struct{}, err error) {
+	ctx, cancel := parent, context.CancelFunc(func() {})
+	if timeout > 0 {
+		ctx, cancel = context.WithTimeout(parent, timeout)
+	}
+	defer cancel()
 
 	// Execute user's Change method with context monitoring
 	resultChan := make(chan struct {
 		result string
-		err    error
+		failed bool
 	}, 1)
 
+	changeDone := make(chan struct{})
 	go func() {
+		defer close(changeDone)
+		var lastFailed atomic.Bool
 		// Use helper to safely collect progress messages
-		progressChan, done := f.collectProgressMessages(func(msg string) {
+		progressChan, done := f.collectProgressMessages(ctx, func(msg MessageUpdate) {
+			if ctx.Err() != nil {
+				return // attempt abandoned (timeout/cancel): its late progress is not shown
+			}
+			lastFailed.Store(msg.Type == Msg.Error)
+			if percent, ok := progressPercent(msg.Content); ok {
+				f.asyncState.setPercent(percent)
+			}
 			if f.parentTab != nil {
 				if f.hasContentMethod() {
 					f.parentTab.tui.updateViewport()
 					return
 				}
-				f.sendMessage(msg)
+				f.sendUpdate(msg)
 			}
 		})
+		var closeOnce sync.Once
+		closeProgress := func() {
+			closeOnce.Do(func() { close(progressChan) })
+			<-done
+		}
 
 		// Ensure channel is closed when goroutine exits, even if context is cancelled
 		// Use defer with panic recovery to prevent crashes
@@ -324,22 +563,11 @@ func (f *field) executeAsyncChange(valueToSave any) {
 					f.parentTab.tui.Logger("Internal error in handler goroutine:", r)
 				}
 			}
-			// Safely close the channel
-			func() {
-				defer func() {
-					if r := recover(); r != nil {
-						// Channel might already be closed, log but don't crash
-						if f.parentTab != nil && f.parentTab.tui != nil && f.parentTab.tui.Logger != nil {
-							f.parentTab.tui.Logger("Channel close error (expected if timeout occurred):", r)
-						}
-					}
-				}()
-				close(progressChan)
-			}()
-			<-done
+			closeProgress()
 		}()
 
-		f.handler.Change(currentValue.(string), progressChan)
+		f.handler.changeTyped(value, progressChan)
+		closeProgress() // every progress message is counted before the result
 
 		// Only send result if context wasn't cancelled
 		select {
@@ -347,53 +575,20 @@ func (f *field) executeAsyncChange(valueToSave any) {
 			// Context was cancelled, don't send result
 			return
 		default:
-			result := f.handler.Value() // Obtener valor actualizado
 			resultChan <- struct {
 				result string
-				err    error
-			}{result, nil}
+				failed bool
+			}{f.handler.Value(), lastFailed.Load()} // Obtener valor actualizado
 		}
 	}()
 
 	// Wait for completion or timeout
 	select {
 	case res := <-resultChan:
-		// Operation completed normally
-		f.asyncState.isRunning = false
-
-		if res.err != nil {
-			// Handler decides error message content
-			f.sendMessage(res.err.Error())
-		} else {
-			switch f.handler.handlerType {
-			case handlerTypeEdit:
-				// NEW: If handler has Content() method, only refresh display
-				if f.hasContentMethod() {
-					f.parentTab.tui.updateViewport()
-				} else {
-					f.sendMessage(res.result)
-				}
-			case handlerTypeExecution:
-				// Only send if handler explicitly implements Value()
-				if _, ok := f.handler.origHandler.(interface{ Value() string }); ok {
-					f.sendMessage(res.result)
-				}
-				// Other handler types: do not send success message
-			}
-		}
-
+		return res.result, res.failed, changeDone, nil
 	case <-ctx.Done():
-		// Operation timed out
-		f.asyncState.isRunning = false
-
-		if ctx.Err() == context.DeadlineExceeded {
-			f.sendMessage(Fmt("Operation timed out after %v", timeout))
-		} else {
-			f.sendMessage("Operation was cancelled")
-		}
+		return "", false, changeDone, ctx.Err()
 	}
-
-	cancel() // Clean up context
 }
 
 // executeChangeSyncWithValue executes the handler's Change method synchronously with pre-captured value
@@ -405,14 +600,29 @@ func (f *field) executeChangeSyncWithValue(valueToSave any) {
 	// In sync test mode, we don't generate operation IDs or send messages to avoid race conditions
 	// Use the pre-captured value directly
 
-	// Use helper to safely collect progress messages (discarding them in test mode)
-	progressChan, done := f.collectProgressMessages(func(msg string) {
-		// In sync test mode, we don't send messages to avoid race conditions
-	})
+	// RetryPolicy() applies here too: an attempt ending with an error message is retried
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	if f.asyncState != nil {
+		f.asyncState.setCancel(cancel)
+		defer f.asyncState.setCancel(nil)
+	}
+	attempts, backoff := f.retryPolicy()
+	for attempt := 1; ; attempt++ {
+		var failed bool
+		// Use helper to safely collect progress messages (discarding them in test mode)
+		progressChan, done := f.collectProgressMessages(ctx, func(msg MessageUpdate) {
+			// In sync test mode, we don't send messages to avoid race conditions
+			failed = msg.Type == Msg.Error
+		})
 
-	f.handler.Change(valueToSave.(string), progressChan)
-	close(progressChan)
-	<-done
+		f.handler.changeTyped(valueToSave.(string), progressChan)
+		close(progressChan)
+		<-done
+		if !failed || attempt >= attempts || !sleepContext(ctx, backoff) {
+			break
+		}
+	}
 	// In test mode, we don't send messages to UI to avoid race conditions
 	// The test can verify the handler's internal state directly
 }
@@ -441,7 +651,7 @@ func (f *field) executeChangeSyncWithTracking(valueToSave any) {
 	handlerColor := f.handler.handlerColor // NEW: Get handler color
 
 	// Use helper to safely collect progress messages
-	progressChan, done := f.collectProgressMessages(func(msg string) {
+	progressChan, done := f.collectProgressMessages(context.Background(), func(msg MessageUpdate) {
 		if f.parentTab != nil {
 			// NEW: If handler has Content() method, refresh display instead of creating messages
 			if f.hasContentMethod() {
@@ -449,13 +659,12 @@ func (f *field) executeChangeSyncWithTracking(valueToSave any) {
 				return
 			}
 			// For regular handlers, create timestamped messages with tracking
-			_, msgType := Translate(msg).StringType()
-			f.parentTab.tui.sendMessageWithHandler(msg, msgType, f.parentTab, handlerName, operationID, handlerColor)
+			f.parentTab.tui.sendUpdateWithHandler(msg, f.parentTab, handlerName, operationID, handlerColor)
 		}
 	})
 
 	// Execute handler
-	f.handler.Change(valueToSave.(string), progressChan)
+	f.handler.changeTyped(valueToSave.(string), progressChan)
 	close(progressChan)
 	<-done
 
@@ -488,7 +697,15 @@ func (f *field) handleEnter() {
 	}
 
 	// Capture the current value BEFORE any state changes
-	valueToSave := f.getCurrentValue()
+	f.handleEnterWithValue(f.getCurrentValue())
+}
+
+// handleEnterWithValue runs the handler with value through the same async/timeout
+// path as pressing Enter (synchronous in test mode). Used by global shortcuts.
+func (f *field) handleEnterWithValue(valueToSave any) {
+	if f.handler == nil || f.isDisplayOnly() {
+		return
+	}
 
 	// In test mode, execute synchronously without goroutine
 	if f.parentTab != nil && f.parentTab.tui != nil && f.parentTab.tui.isTestMode() {
@@ -496,6 +713,10 @@ func (f *field) handleEnter() {
 		return
 	}
 
-	// DevTUI handles async internally - user doesn't see this complexity
+	// DevTUI handles async internally - user doesn't see this complexity.
+	// Marked running before the goroutine starts so HasRunningOperations never misses it.
+	if f.asyncState != nil {
+		f.asyncState.setRunning(true, f.now())
+	}
 	go f.executeAsyncChange(valueToSave)
 }
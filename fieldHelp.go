@@ -0,0 +1,43 @@
+package devtui
+
+import . "github.com/cdvelop/tinystring"
+
+// Field help: a field handler implementing Help() string shows a "?" marker in the
+// footer; pressing ? in normal mode opens its description over the content area and
+// Esc, ? again or selecting another field closes it.
+
+// fieldHelpMark is shown in the footer when the active field has help text
+const fieldHelpMark = "?"
+
+// helpText returns the handler's Help() description, or "" when it has none
+func (f *field) helpText() string {
+	if f == nil || f.handler == nil || f.handler.helpFunc == nil {
+		return ""
+	}
+	return f.handler.helpFunc()
+}
+
+// activeFieldHelp returns the help of the field selected in the active tab
+func (h *DevTUI) activeFieldHelp() string {
+	return h.activeField().helpText()
+}
+
+// fieldHelpOpen reports whether the field help overlay is visible (hidden in edit mode;
+// moving to another field closes it)
+func (h *DevTUI) fieldHelpOpen() bool {
+	return h.showFieldHelp && !h.editModeActivated && h.activeFieldHelp() != ""
+}
+
+// fieldHelpBox renders the active field's label and help text
+func (h *DevTUI) fieldHelpBox() string {
+	field := h.activeField()
+	return h.renderOverlayBox(field.title(), field.helpText(), Translate("Esc/? ", terms.Close).String())
+}
+
+// title returns the field's Label(), or Name() for display/table handlers without one
+func (f *field) title() string {
+	if label := f.handler.Label(); label != "" {
+		return label
+	}
+	return f.handler.Name()
+}
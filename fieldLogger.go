@@ -0,0 +1,136 @@
+package devtui
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	. "github.com/cdvelop/tinystring"
+)
+
+// addFieldLogger - internal method (lowercase, private)
+func (ts *tabSection) addFieldLogger(name string, color string) func(fields map[string]any) {
+	color = ts.tui.resolveHandlerColor(color)
+
+	anyH := NewWriterHandler(&simpleWriterHandler{name: name}, color)
+	anyH.structuredFields = true
+
+	ts.mu.Lock()
+	ts.writingHandlers = append(ts.writingHandlers, anyH)
+	ts.mu.Unlock()
+
+	return func(fields map[string]any) {
+		if len(fields) == 0 {
+			return
+		}
+
+		msg := formatFields(fields)
+		msgType := fieldsMessageType(fields)
+		ts.tui.sendMessageWithHandler(msg, msgType, ts, name, "", anyH.handlerColor)
+
+		if msgType == Msg.Error {
+			ts.tui.Logger(msg)
+		}
+	}
+}
+
+// AddStructuredLogger creates a logger that prints msg followed by key=value pairs in the
+// given order, e.g. log("build done", "duration", "2s", "files", 42) renders
+// "build done duration=2s files=42" with keys styled differently.
+// A dangling key without value is rendered as !BADKEY=value (same as log/slog).
+func (ts *tabSection) AddStructuredLogger(name, color string) func(msg string, kv ...any) {
+	color = ts.tui.resolveHandlerColor(color)
+
+	anyH := NewWriterHandler(&simpleWriterHandler{name: name}, color)
+	anyH.structuredFields = true
+
+	ts.mu.Lock()
+	ts.writingHandlers = append(ts.writingHandlers, anyH)
+	ts.mu.Unlock()
+
+	return func(msg string, kv ...any) {
+		line := msg
+		if pairs := formatKeyValues(kv); pairs != "" {
+			line = strings.TrimSpace(line + " " + pairs)
+		}
+		if line == "" {
+			return
+		}
+
+		message, msgType := Translate(line).StringType()
+		ts.tui.sendMessageWithHandler(message, msgType, ts, name, "", anyH.handlerColor)
+
+		if msgType == Msg.Error {
+			ts.tui.Logger(line)
+		}
+	}
+}
+
+// formatKeyValues renders alternating key/value arguments as "key=value" pairs in order
+func formatKeyValues(kv []any) string {
+	if len(kv)%2 != 0 {
+		kv = append(kv[:len(kv)-1:len(kv)-1], "!BADKEY", kv[len(kv)-1])
+	}
+	pairs := make([]string, 0, len(kv)/2)
+	for i := 0; i < len(kv); i += 2 {
+		pairs = append(pairs, fmt.Sprintf("%v", kv[i])+"="+formatFieldValue(kv[i+1]))
+	}
+	return strings.Join(pairs, " ")
+}
+
+// isFieldLogger reports whether handlerName belongs to a structured logger of this tab
+func (ts *tabSection) isFieldLogger(handlerName string) bool {
+	if handlerName == "" {
+		return false
+	}
+	if handler := ts.getWritingHandler(handlerName); handler != nil {
+		return handler.structuredFields
+	}
+	return false
+}
+
+// formatFields renders fields as "key=value" pairs sorted by key.
+// Values containing spaces, '=' or quotes are quoted so pairs stay unambiguous.
+func formatFields(fields map[string]any) string {
+	keys := make([]string, 0, len(fields))
+	for key := range fields {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	pairs := make([]string, 0, len(keys))
+	for _, key := range keys {
+		pairs = append(pairs, key+"="+formatFieldValue(fields[key]))
+	}
+	return strings.Join(pairs, " ")
+}
+
+// formatFieldValue quotes values containing spaces, '=' or quotes so pairs stay unambiguous
+func formatFieldValue(v any) string {
+	value := fmt.Sprintf("%v", v)
+	if value == "" || strings.ContainsAny(value, " =\"\n\t") {
+		return strconv.Quote(value)
+	}
+	return value
+}
+
+// fieldsMessageType maps the "level" field to a MessageType (Normal when absent or unknown)
+func fieldsMessageType(fields map[string]any) MessageType {
+	level, ok := fields["level"]
+	if !ok {
+		return Msg.Normal
+	}
+	switch strings.ToLower(fmt.Sprintf("%v", level)) {
+	case "error", "err", "fatal", "panic":
+		return Msg.Error
+	case "warn", "warning":
+		return Msg.Warning
+	case "info":
+		return Msg.Info
+	case "success", "ok":
+		return Msg.Success
+	default:
+		return Msg.Normal
+	}
+}
@@ -0,0 +1,97 @@
+package devtui
+
+import (
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/x/ansi"
+)
+
+// portHelpHandler is an edit field describing itself via Help()
+type portHelpHandler struct {
+	*TestEditableHandler
+}
+
+func (p *portHelpHandler) Help() string { return "TCP port the dev server listens on" }
+
+func setupFieldHelpTest(t *testing.T) *DevTUI {
+	t.Helper()
+	h := DefaultTUIForTest()
+	h.viewport.Width = 80
+	h.viewport.Height = 12
+	h.windowHeight = 20
+	tab := h.NewTabSection("Config", "Field help test")
+	h.AddHandler(&portHelpHandler{NewTestEditableHandler("Port", "8080")}, 0, "", tab)
+	h.AddHandler(NewTestEditableHandler("Host", "localhost"), 0, "", tab)
+	h.activeTab = tab.(*tabSection).index
+	return h
+}
+
+func pressHelp(h *DevTUI) {
+	h.handleKeyboard(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'?'}})
+}
+
+func TestFieldHelpToggleWithQuestionMark(t *testing.T) {
+	h := setupFieldHelpTest(t)
+
+	if footer := ansi.Strip(h.renderFooterInfo()); !strings.Contains(footer, fieldHelpMark) {
+		t.Errorf("Expected the footer to show %q for a field with help, got %q", fieldHelpMark, footer)
+	}
+
+	pressHelp(h)
+	if !h.fieldHelpOpen() {
+		t.Fatal("Expected ? to open the field help")
+	}
+	frame := h.RenderFrame(true)
+	if !strings.Contains(frame, "TCP port the dev server listens on") || !strings.Contains(frame, "Port") {
+		t.Errorf("Expected the overlay with label and help text, got:\n%s", frame)
+	}
+
+	pressHelp(h)
+	if h.fieldHelpOpen() {
+		t.Error("Expected ? again to close the field help")
+	}
+
+	pressHelp(h)
+	h.handleKeyboard(tea.KeyMsg{Type: tea.KeyEsc})
+	if h.fieldHelpOpen() {
+		t.Error("Expected Esc to close the field help")
+	}
+	if strings.Contains(h.RenderFrame(true), "TCP port") {
+		t.Error("Expected the overlay to be gone after closing")
+	}
+}
+
+func TestFieldHelpClosesOnNavigation(t *testing.T) {
+	h := setupFieldHelpTest(t)
+
+	pressHelp(h)
+	h.handleKeyboard(tea.KeyMsg{Type: tea.KeyRight})
+	if h.fieldHelpOpen() || h.showFieldHelp {
+		t.Error("Expected selecting another field to close the help")
+	}
+
+	// The second field has no Help(): no marker is shown and ? opens the global help
+	pressHelp(h)
+	if h.showFieldHelp || !h.showGlobalHelp {
+		t.Error("Expected ? on a field without help to open the global help instead")
+	}
+	if footer := ansi.Strip(h.renderFooterInfo()); strings.Contains(footer, fieldHelpMark) {
+		t.Errorf("Expected no help marker, got %q", footer)
+	}
+}
+
+func TestPlaceOverlayKeepsSurroundingContent(t *testing.T) {
+	base := strings.Join([]string{"aaaaaaaaaa", "bbbbbbbbbb", "cccccccccc"}, "\n")
+	got := placeOverlay(base, "XX", 10)
+	want := strings.Join([]string{"aaaaaaaaaa", "bbbbXXbbbb", "cccccccccc"}, "\n")
+	if got != want {
+		t.Errorf("Expected box centered over the base:\n%s\ngot:\n%s", want, got)
+	}
+
+	// Short lines are padded up to the box position
+	if got := placeOverlay("ab", "XX", 10); got != "ab  XX" {
+		t.Errorf("Expected short line padded before the box, got %q", got)
+	}
+}
@@ -0,0 +1,85 @@
+package devtui
+
+import (
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestOutOfRangeFieldIndexIsClamped(t *testing.T) {
+	keys := []tea.KeyMsg{
+		{Type: tea.KeyEnter},
+		{Type: tea.KeyUp},
+		{Type: tea.KeyDown},
+		{Type: tea.KeyRight},
+		{Type: tea.KeyLeft},
+		{Type: tea.KeyEsc},
+	}
+
+	for _, index := range []int{5, -1} {
+		var logs []string
+		h := DefaultTUIForTest(func(messages ...any) {
+			for _, m := range messages {
+				if s, ok := m.(string); ok {
+					logs = append(logs, s)
+				}
+			}
+		})
+		h.WarnFieldIndexClamp = true
+		h.viewport.Width = 80
+		h.viewport.Height = 10
+
+		tab := h.NewTabSection("Clamp", "Out of range index")
+		h.AddHandler(NewTestEditableHandler("Name", "value"), 0, "", tab)
+		h.AddHandler(NewTestNonEditableHandler("Run", "Run"), 0, "", tab)
+		ts := tab.(*tabSection)
+		h.activeTab = ts.index
+
+		for _, key := range keys {
+			ts.indexActiveEditField = index
+			h.handleKeyboard(key)
+			if ts.indexActiveEditField < 0 || ts.indexActiveEditField >= len(ts.fieldHandlers) {
+				t.Errorf("index %d, key %v: field index left out of range: %d", index, key, ts.indexActiveEditField)
+			}
+		}
+
+		// Edit mode path
+		ts.indexActiveEditField = index
+		h.editModeActivated = true
+		h.handleKeyboard(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'x'}})
+		h.editModeActivated = false
+
+		// Rendering and interactive trigger paths
+		ts.indexActiveEditField = index
+		h.checkAndTriggerInteractiveContent()
+		ts.indexActiveEditField = index
+		_ = h.ContentView()
+		ts.indexActiveEditField = index
+		_ = h.footerView()
+		ts.indexActiveEditField = index
+		_ = h.keyHints()
+
+		clamped := false
+		for _, l := range logs {
+			if strings.Contains(l, "out of range") {
+				clamped = true
+			}
+		}
+		if !clamped {
+			t.Errorf("index %d: expected clamping to be logged with WarnFieldIndexClamp, got %v", index, logs)
+		}
+	}
+}
+
+func TestEditModeWithoutFieldsDoesNotPanic(t *testing.T) {
+	h := DefaultTUIForTest()
+	tab := h.NewTabSection("Empty", "No fields")
+	h.activeTab = tab.(*tabSection).index
+	h.editModeActivated = true
+
+	h.handleKeyboard(tea.KeyMsg{Type: tea.KeyEnter})
+	if h.editModeActivated {
+		t.Error("Expected edit mode to be left when the tab has no fields")
+	}
+}
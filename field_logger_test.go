@@ -0,0 +1,81 @@
+package devtui
+
+import (
+	"strings"
+	"testing"
+
+	. "github.com/cdvelop/tinystring"
+)
+
+func TestFieldLoggerErrorLevel(t *testing.T) {
+	h := DefaultTUIForTest()
+	tab := h.NewTabSection("SERVER", "HTTP")
+
+	log := h.AddFieldLogger("HTTP", "", tab)
+	log(map[string]any{"level": "error", "msg": "boom"})
+
+	ts := tab.(*tabSection)
+	if len(ts.tabContents) != 1 {
+		t.Fatalf("Expected 1 message, got %d", len(ts.tabContents))
+	}
+
+	msg := ts.tabContents[0]
+	if msg.Type != Msg.Error {
+		t.Errorf("Expected Error message type, got %v", msg.Type)
+	}
+	if !strings.Contains(msg.Content, "msg=boom") {
+		t.Errorf("Expected content to contain 'msg=boom', got %q", msg.Content)
+	}
+	if !strings.Contains(h.formatMessage(msg), "boom") {
+		t.Errorf("Expected rendered message to contain 'boom', got %q", h.formatMessage(msg))
+	}
+}
+
+func TestFormatFields(t *testing.T) {
+	got := formatFields(map[string]any{"status": 500, "msg": "not found", "path": "/a=b"})
+	want := `msg="not found" path="/a=b" status=500`
+	if got != want {
+		t.Errorf("Expected %q, got %q", want, got)
+	}
+}
+
+func TestFieldsMessageType(t *testing.T) {
+	cases := map[string]MessageType{
+		"error":   Msg.Error,
+		"WARN":    Msg.Warning,
+		"info":    Msg.Info,
+		"success": Msg.Success,
+		"debug":   Msg.Normal,
+	}
+	for level, want := range cases {
+		if got := fieldsMessageType(map[string]any{"level": level}); got != want {
+			t.Errorf("level %q: expected %v, got %v", level, want, got)
+		}
+	}
+	if got := fieldsMessageType(map[string]any{"msg": "no level"}); got != Msg.Normal {
+		t.Errorf("Expected Normal without level, got %v", got)
+	}
+}
+
+func TestStructuredLoggerRendersPairsInOrder(t *testing.T) {
+	h := DefaultTUIForTest()
+	h.viewport.Width = 120
+	tab := h.NewTabSection("BUILD", "Compiler")
+
+	log := tab.(*tabSection).AddStructuredLogger("Compiler", "")
+	log("build done", "duration", "2s", "files", 42)
+
+	ts := tab.(*tabSection)
+	if got := ts.tabContents[0].Content; got != "build done duration=2s files=42" {
+		t.Errorf("Expected 'build done duration=2s files=42', got %q", got)
+	}
+	if !ts.isFieldLogger("Compiler") {
+		t.Error("Structured logger keys should be styled as fields")
+	}
+}
+
+func TestFormatKeyValuesOddLength(t *testing.T) {
+	if got := formatKeyValues([]any{"files", 42, "dangling"}); got != "files=42 !BADKEY=dangling" {
+		t.Errorf("Expected dangling value under !BADKEY, got %q", got)
+	}
+}
@@ -0,0 +1,53 @@
+package devtui
+
+import (
+	"io/fs"
+	"os"
+	"slices"
+	"strings"
+)
+
+// Path completion: edit handlers implementing FileSystem() DirReader (HandlerFilePicker)
+// complete the value with Tab in edit mode (see completion.go); directories get a
+// trailing "/" so the next Tab continues inside them.
+
+// DirReader lists a directory for path completion, so tests can use a fake filesystem.
+type DirReader interface {
+	ReadDir(dir string) ([]fs.DirEntry, error)
+}
+
+// osDirReader reads the real filesystem
+type osDirReader struct{}
+
+func (osDirReader) ReadDir(dir string) ([]fs.DirEntry, error) { return os.ReadDir(dir) }
+
+// pathCandidates lists the full paths starting with value, sorted; directories end in "/".
+// Hidden entries are only offered when the typed name starts with ".".
+func pathCandidates(reader DirReader, value string) []string {
+	dir, prefix := "", value
+	if i := strings.LastIndex(value, "/"); i >= 0 {
+		dir, prefix = value[:i+1], value[i+1:]
+	}
+	readDir := dir
+	if readDir == "" {
+		readDir = "."
+	}
+	entries, err := reader.ReadDir(readDir)
+	if err != nil {
+		return nil
+	}
+
+	var candidates []string
+	for _, entry := range entries {
+		name := entry.Name()
+		if !strings.HasPrefix(name, prefix) || (strings.HasPrefix(name, ".") && !strings.HasPrefix(prefix, ".")) {
+			continue
+		}
+		if entry.IsDir() {
+			name += "/"
+		}
+		candidates = append(candidates, dir+name)
+	}
+	slices.Sort(candidates)
+	return candidates
+}
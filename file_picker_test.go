@@ -0,0 +1,135 @@
+package devtui
+
+import (
+	"io/fs"
+	"strings"
+	"testing"
+	"testing/fstest"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// fakeDirReader serves absolute paths from an in-memory filesystem
+type fakeDirReader struct {
+	fsys fstest.MapFS
+}
+
+func (r fakeDirReader) ReadDir(dir string) ([]fs.DirEntry, error) {
+	name := strings.Trim(dir, "/")
+	if name == "" {
+		name = "."
+	}
+	return r.fsys.ReadDir(name)
+}
+
+// pathHandler is an edit field completing paths against a fake filesystem
+type pathHandler struct {
+	*TestEditableHandler
+	reader DirReader
+}
+
+func (p *pathHandler) FileSystem() DirReader { return p.reader }
+
+func setupFilePickerTest(t *testing.T, value string) (*DevTUI, *field) {
+	t.Helper()
+	reader := fakeDirReader{fstest.MapFS{
+		"tmp/cache.db":       {},
+		"home/user/.bashrc":  {},
+		"home/user/notes.md": {},
+		"home/user/go/x.go":  {},
+		"home/user/games":    {},
+		"usr/bin/go":         {},
+	}}
+	h := DefaultTUIForTest()
+	h.viewport.Width = 80
+	tab := h.NewTabSection("Config", "File picker test")
+	h.AddHandler(&pathHandler{NewTestEditableHandler("Project", value), reader}, 0, "", tab)
+	h.activeTab = tab.(*tabSection).index
+	f := tab.(*tabSection).fieldHandlers[0]
+	h.handleKeyboard(tea.KeyMsg{Type: tea.KeyEnter})
+	f.setCursorAtEnd()
+	return h, f
+}
+
+func pressTab(h *DevTUI) {
+	h.handleKeyboard(tea.KeyMsg{Type: tea.KeyTab})
+}
+
+func TestFilePickerCompletesSingleMatch(t *testing.T) {
+	h, f := setupFilePickerTest(t, "/tm")
+
+	pressTab(h)
+	if f.tempEditValue != "/tmp/" || f.cursor != len("/tmp/") {
+		t.Fatalf("Expected Tab to complete \"/tm\" to \"/tmp/\", got %q cursor %d", f.tempEditValue, f.cursor)
+	}
+	if !h.editModeActivated {
+		t.Error("Expected Tab to stay in edit mode")
+	}
+
+	pressTab(h)
+	if f.tempEditValue != "/tmp/cache.db" {
+		t.Errorf("Expected the file inside the directory, got %q", f.tempEditValue)
+	}
+
+	h.handleKeyboard(tea.KeyMsg{Type: tea.KeyCtrlZ})
+	if f.tempEditValue != "/tmp/" {
+		t.Errorf("Expected completion to be undoable, got %q", f.tempEditValue)
+	}
+}
+
+func TestFilePickerCyclesCandidates(t *testing.T) {
+	h, f := setupFilePickerTest(t, "/home/user/")
+
+	// Hidden .bashrc is skipped; directories end with "/"
+	for _, want := range []string{"/home/user/games", "/home/user/go/", "/home/user/notes.md", "/home/user/games"} {
+		pressTab(h)
+		if f.tempEditValue != want {
+			t.Fatalf("Expected %q, got %q", want, f.tempEditValue)
+		}
+	}
+
+	// Any other key ends the cycle: the next Tab completes from the edited value
+	for range len("games") {
+		h.handleKeyboard(tea.KeyMsg{Type: tea.KeyBackspace})
+	}
+	h.handleKeyboard(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'n'}})
+	pressTab(h)
+	if f.tempEditValue != "/home/user/notes.md" {
+		t.Errorf("Expected completion from the edited value, got %q", f.tempEditValue)
+	}
+}
+
+func TestFilePickerCompletesCommonPrefixFirst(t *testing.T) {
+	h, f := setupFilePickerTest(t, "/home/user/g")
+
+	// games and go/ share only "g": nothing longer to complete, so Tab starts cycling
+	pressTab(h)
+	if f.tempEditValue != "/home/user/games" {
+		t.Errorf("Expected the first candidate, got %q", f.tempEditValue)
+	}
+
+	h, f = setupFilePickerTest(t, "/ho")
+	reader := fakeDirReader{fstest.MapFS{"home/a": {}, "hosts/b": {}, "houses/c": {}}}
+	f.handler.fileSystemFunc = func() DirReader { return reader }
+	f.tempEditValue = "/h"
+	f.setCursorAtEnd()
+	pressTab(h)
+	if f.tempEditValue != "/ho" {
+		t.Errorf("Expected Tab to extend to the common prefix \"/ho\", got %q", f.tempEditValue)
+	}
+}
+
+func TestFilePickerWithoutMatchesKeepsValue(t *testing.T) {
+	h, f := setupFilePickerTest(t, "/nothing")
+
+	pressTab(h)
+	if f.tempEditValue != "/nothing" {
+		t.Errorf("Expected the value unchanged without matches, got %q", f.tempEditValue)
+	}
+}
+
+func TestCommonPrefixKeepsWholeRunes(t *testing.T) {
+	if got := commonPrefix([]string{"/é1", "/è2"}); got != "/" {
+		t.Errorf("Expected the prefix to stop before a partial rune, got %q", got)
+	}
+}
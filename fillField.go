@@ -0,0 +1,45 @@
+package devtui
+
+import "errors"
+
+// FillField focuses the field whose handler is named name, types value into it and commits
+// it as if Enter was pressed, for scripted flows. Like Enter, the change runs in the
+// background (synchronously in test mode).
+//
+// Example:
+//
+//	tab.(interface{ FillField(string, string) error }).FillField("ServerPort", "8080")
+func (ts *tabSection) FillField(name, value string) error {
+	index := -1
+	for i, f := range ts.fieldHandlers {
+		if f.handler != nil && f.handler.Name() == name {
+			index = i
+			break
+		}
+	}
+	if index < 0 {
+		return errors.New("field not found: " + name)
+	}
+
+	f := ts.fieldHandlers[index]
+	if !f.editable() {
+		return errors.New("field not editable: " + name)
+	}
+
+	h := ts.tui
+	if ts.index >= 0 {
+		h.switchTab(ts.index)
+	}
+	ts.indexActiveEditField = index
+	h.syncFocus()
+
+	// Same steps as typing the value in edit mode and pressing Enter
+	h.editingConfigOpen(true, f, "")
+	f.tempEditValue = value
+	f.cursor = len([]rune(value))
+	f.handleEnter()
+	h.editingConfigOpen(false, f, "")
+	f.tempEditValue = ""
+	h.updateViewport()
+	return nil
+}
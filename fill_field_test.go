@@ -0,0 +1,49 @@
+package devtui
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestFillFieldCommitsValue(t *testing.T) {
+	h := DefaultTUIForTest()
+	h.viewport.Width = 80
+	h.viewport.Height = 20
+	tab := h.NewTabSection("Server", "Fill field test")
+	h.AddHandler(NewTestEditableHandler("Host", "localhost"), 0, "", tab)
+	port := NewTestEditableHandler("Port", "3000")
+	h.AddHandler(port, 0, "", tab)
+	ts := tab.(*tabSection)
+
+	if err := ts.FillField(port.Name(), "8080"); err != nil {
+		t.Fatalf("FillField returned error: %v", err)
+	}
+
+	if got := port.Value(); got != "8080" {
+		t.Errorf("Expected handler to receive 8080, got %q", got)
+	}
+	if h.activeTab != ts.index || ts.indexActiveEditField != 1 {
+		t.Errorf("Expected port field focused, got tab %d field %d", h.activeTab, ts.indexActiveEditField)
+	}
+	if h.editModeActivated {
+		t.Error("Expected edit mode to be closed after commit")
+	}
+	if ts.fieldHandlers[1].tempEditValue != "" {
+		t.Errorf("Expected temporary edit value cleared, got %q", ts.fieldHandlers[1].tempEditValue)
+	}
+}
+
+func TestFillFieldErrors(t *testing.T) {
+	h := DefaultTUIForTest()
+	tab := h.NewTabSection("Server", "Fill field test")
+	h.AddHandler(NewTestNonEditableHandler("Deploy", "deploy"), 0, "", tab)
+	ts := tab.(*tabSection)
+
+	if err := ts.FillField("Missing", "x"); err == nil || !strings.Contains(err.Error(), "not found") {
+		t.Errorf("Expected not found error, got %v", err)
+	}
+	name := ts.fieldHandlers[0].handler.Name()
+	if err := ts.FillField(name, "x"); err == nil || !strings.Contains(err.Error(), "not editable") {
+		t.Errorf("Expected not editable error, got %v", err)
+	}
+}
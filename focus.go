@@ -1,40 +1,44 @@
-package devtui
-
-import (
-	"errors"
-	"fmt"
-	"os"
-	"os/exec"
-	"runtime"
-	"time"
-)
-
-func (t *DevTUI) ReturnFocus() error {
-
-	time.Sleep(100 * time.Millisecond)
-
-	pid := os.Getpid()
-
-	switch runtime.GOOS {
-	case "linux":
-		cmd := exec.Command("xdotool", "search", "--pid", fmt.Sprint(pid), "windowactivate")
-		return cmd.Run()
-
-	case "darwin":
-		cmd := exec.Command("osascript", "-e", fmt.Sprintf(`
-            tell application "System Events"
-                set frontmost of the first process whose unix id is %d to true
-            end tell
-        `, pid))
-		return cmd.Run()
-
-	case "windows":
-		// Usando taskkill para verificar si el proceso existe y obtener su ventana
-		cmd := exec.Command("cmd", "/C", fmt.Sprintf("tasklist /FI \"PID eq %d\" /FO CSV /NH", pid))
-		return cmd.Run()
-
-	default:
-		return errors.New("focus unsupported platform")
-	}
-
-}
+package devtui
+
+// FocusListener is an optional interface for field handlers that react to gaining or
+// losing focus (e.g. start/stop a live refresh while the field is selected).
+// OnBlur is called on the previously focused field before OnFocus on the new one,
+// both from the UI goroutine, whenever field navigation or tab switching changes
+// the active field.
+type FocusListener interface {
+	OnFocus()
+	OnBlur()
+}
+
+// focusListener returns the handler as FocusListener when it implements it
+func (f *field) focusListener() FocusListener {
+	if f == nil || f.handler == nil {
+		return nil
+	}
+	listener, _ := f.handler.origHandler.(FocusListener)
+	return listener
+}
+
+// activeField returns the selected field of the active tab, or nil
+func (h *DevTUI) activeField() *field {
+	if h.activeTab >= len(h.TabSections) {
+		return nil
+	}
+	return h.TabSections[h.activeTab].activeField()
+}
+
+// syncFocus fires OnBlur/OnFocus when the active field changed since the last call
+func (h *DevTUI) syncFocus() {
+	current := h.activeField()
+	if current == h.focusedField {
+		return
+	}
+	if listener := h.focusedField.focusListener(); listener != nil {
+		listener.OnBlur()
+	}
+	h.focusedField = current
+	h.showFieldHelp = false // la ayuda describe el campo en el que se abrió
+	if listener := current.focusListener(); listener != nil {
+		listener.OnFocus()
+	}
+}
@@ -0,0 +1,51 @@
+package devtui
+
+import (
+	"reflect"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// focusRecorder is an edit handler recording focus callbacks into a shared log
+type focusRecorder struct {
+	*TestEditableHandler
+	events *[]string
+}
+
+func (r *focusRecorder) OnFocus() { *r.events = append(*r.events, "focus:"+r.Label()) }
+func (r *focusRecorder) OnBlur()  { *r.events = append(*r.events, "blur:"+r.Label()) }
+
+func TestFocusListenerCallbacksOrder(t *testing.T) {
+	h := DefaultTUIForTest()
+	h.viewport.Width = 80
+	h.viewport.Height = 10
+
+	var events []string
+	tab := h.NewTabSection("Live", "Focus test")
+	h.AddHandler(&focusRecorder{NewTestEditableHandler("First", "1"), &events}, 0, "", tab)
+	h.AddHandler(&focusRecorder{NewTestEditableHandler("Second", "2"), &events}, 0, "", tab)
+	h.activeTab = GetFirstTestTabIndex()
+
+	h.Init()
+	h.handleKeyboard(tea.KeyMsg{Type: tea.KeyRight}) // First -> Second
+	h.handleKeyboard(tea.KeyMsg{Type: tea.KeyLeft})  // Second -> First
+	h.handleKeyboard(tea.KeyMsg{Type: tea.KeyTab})   // switch tab: First loses focus
+
+	want := []string{
+		"focus:First",
+		"blur:First", "focus:Second",
+		"blur:Second", "focus:First",
+		"blur:First",
+	}
+	if !reflect.DeepEqual(events, want) {
+		t.Errorf("Expected focus events %v, got %v", want, events)
+	}
+
+	// Keys that do not change the active field fire nothing
+	events = nil
+	h.handleKeyboard(tea.KeyMsg{Type: tea.KeyUp})
+	if len(events) != 0 {
+		t.Errorf("Expected no focus events without navigation, got %v", events)
+	}
+}
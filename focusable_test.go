@@ -0,0 +1,54 @@
+package devtui
+
+import (
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// pinnedInfoHandler is a display handler that opts out of focus navigation
+type pinnedInfoHandler struct{}
+
+func (p *pinnedInfoHandler) Name() string    { return "PinnedInfo" }
+func (p *pinnedInfoHandler) Content() string { return "always visible info" }
+func (p *pinnedInfoHandler) Focusable() bool { return false }
+
+func TestNavigationSkipsNonFocusableField(t *testing.T) {
+	h := DefaultTUIForTest()
+	h.viewport.Width = 80
+	h.viewport.Height = 20
+
+	tab := h.NewTabSection("Config", "Focus order")
+	h.AddHandler(NewTestEditableHandler("First", "1"), 0, "", tab)
+	h.AddHandler(&pinnedInfoHandler{}, 0, "", tab)
+	h.AddHandler(NewTestEditableHandler("Third", "3"), 0, "", tab)
+
+	ts := tab.(*tabSection)
+	h.activeTab = ts.index
+
+	h.handleKeyboard(tea.KeyMsg{Type: tea.KeyRight})
+	if ts.indexActiveEditField != 2 {
+		t.Fatalf("Right should skip non-focusable field, expected index 2, got %d", ts.indexActiveEditField)
+	}
+
+	h.handleKeyboard(tea.KeyMsg{Type: tea.KeyLeft})
+	if ts.indexActiveEditField != 0 {
+		t.Fatalf("Left should skip non-focusable field, expected index 0, got %d", ts.indexActiveEditField)
+	}
+
+	if content := h.ContentView(); !strings.Contains(content, "always visible info") {
+		t.Errorf("Non-focusable display content should still render, got %q", content)
+	}
+}
+
+func TestActiveFieldNeverRestsOnNonFocusable(t *testing.T) {
+	h := DefaultTUIForTest()
+	tab := h.NewTabSection("Config", "Focus order")
+	h.AddHandler(&pinnedInfoHandler{}, 0, "", tab)
+	h.AddHandler(NewTestEditableHandler("Editable", "1"), 0, "", tab)
+
+	if got := tab.(*tabSection).indexActiveEditField; got != 1 {
+		t.Errorf("Expected first focusable field (1) to be active, got %d", got)
+	}
+}
@@ -4,21 +4,59 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/cdvelop/tinystring"
 	"github.com/charmbracelet/lipgloss"
 )
 
-// footerView renderiza la vista del footer
+// footerView renders the footer bar plus the key hint line (TuiConfig.ShowKeyHints)
+// and the active tab status line when one is set, below the ContentFooter() summary
+// of the focused display handler
+func (h *DevTUI) footerView() string {
+	footer := h.footerBarView()
+	if h.activeTab >= len(h.TabSections) {
+		return footer
+	}
+	if h.ShowKeyHints {
+		footer += "\n" + h.keyHintStyle.Width(h.viewport.Width).Render(h.truncate(h.keyHints(), h.viewport.Width))
+	}
+	if status := h.TabSections[h.activeTab].status(); status != "" {
+		footer += "\n" + h.statusLineStyle.Width(h.viewport.Width).Render(h.truncate(status, h.viewport.Width))
+	}
+	// Summary of the focused display handler stays fixed right below the scrolling content
+	if summary := h.contentFooter(); summary != "" {
+		footer = h.contentFooterStyle.Width(h.viewport.Width).Render(h.truncate(summary, h.viewport.Width)) + "\n" + footer
+	}
+	return footer
+}
+
+// contentFooter returns the first line of the ContentFooter() summary of the focused
+// display or table handler, empty when it has none
+func (h *DevTUI) contentFooter() string {
+	f := h.TabSections[h.activeTab].activeField()
+	if f == nil || f.handler == nil || f.handler.contentFooterFunc == nil {
+		return ""
+	}
+	if !f.hasContentMethod() && !f.isTableHandler() {
+		return ""
+	}
+	summary, _, _ := strings.Cut(f.handler.contentFooterFunc(), "\n")
+	return summary
+}
+
+// footerBarView renderiza la vista del footer
 // Si hay campos activos, muestra el campo actual como input
 // Si no hay campos, muestra una barra de desplazamiento estándar
 
-func (h *DevTUI) footerView() string {
+func (h *DevTUI) footerBarView() string {
 	// Verificar que haya tabs disponibles
 	if len(h.TabSections) == 0 {
 		return h.footerInfoStyle.Render("No tabs available")
 	}
 	if h.activeTab >= len(h.TabSections) {
-		h.activeTab = 0
+		h.setActiveIndex(0)
+	}
+
+	if h.pendingClear {
+		return h.footerInfoStyle.Render(clearPrompt())
 	}
 
 	// Si hay campos disponibles, mostrar el input (independiente de si estamos en modo edición)
@@ -47,9 +85,9 @@ func (h *DevTUI) footerView() string {
 	}
 	fieldPagination := fmt.Sprintf("%2d/%2d", displayCurrent, displayTotal)
 	paginationStyled := h.paginationStyle.Render(fieldPagination)
-	info := h.renderScrollInfo()
+	info := h.renderFooterInfo()
 	horizontalPadding := 1
-	spacerStyle := lipgloss.NewStyle().Width(horizontalPadding).Render("")
+	spacerStyle := h.newStyle().Width(horizontalPadding).Render("")
 	lineWidth := h.viewport.Width - lipgloss.Width(info) - lipgloss.Width(paginationStyled) - horizontalPadding*2
 	if lineWidth < 0 {
 		lineWidth = 0
@@ -59,6 +97,40 @@ func (h *DevTUI) footerView() string {
 	return lipgloss.JoinHorizontal(lipgloss.Left, paginationStyled, spacerStyle, line, spacerStyle, info)
 }
 
+// maxBadgeWidthRatio limits the footer badge to a quarter of the viewport width
+const maxBadgeWidthRatio = 4
+
+// renderFooterInfo returns the right side of the footer bar: the progress percentage and
+// elapsed time of the active field's running operation, the active tab badge (SetBadge), truncated to fit,
+// and the scroll indicator
+func (h *DevTUI) renderFooterInfo() string {
+	info := h.renderScrollInfo()
+	if h.activeTab >= len(h.TabSections) {
+		return info
+	}
+	tab := h.TabSections[h.activeTab]
+	if badge := tab.badge(); badge != "" {
+		badge = h.truncate(badge, h.viewport.Width/maxBadgeWidthRatio)
+		info = h.footerBadgeStyle.Render(badge) + " " + info
+	}
+	if elapsed := tab.activeField().elapsedText(); elapsed != "" {
+		info = h.footerBadgeStyle.Render(elapsed) + " " + info
+	}
+	if progress := tab.activeField().progressText(); progress != "" {
+		info = h.footerBadgeStyle.Render(progress) + " " + info
+	}
+	if paused, pending := tab.pauseState(); paused {
+		info = h.footerBadgeStyle.Render(pauseLabel(pending)) + " " + info
+	}
+	if filter := tab.severityFilter().label(); filter != "" {
+		info = h.footerBadgeStyle.Render(filter) + " " + info
+	}
+	if tab.activeField().helpText() != "" {
+		info = h.footerBadgeStyle.Render(fieldHelpMark) + " " + info
+	}
+	return info
+}
+
 // renderScrollInfo returns the formatted scroll percentage with fixed width
 func (h *DevTUI) renderScrollInfo() string {
 	var scrollIcon string
@@ -66,6 +138,11 @@ func (h *DevTUI) renderScrollInfo() string {
 	atTop := h.viewport.AtTop()
 	atBottom := h.viewport.AtBottom()
 
+	// Sticky bottom: new content arrived while the user was reading history
+	if h.newMessagesBelow > 0 && !atBottom {
+		return h.footerInfoStyle.Render(fmt.Sprintf(h.NewMessagesFormat, h.newMessagesBelow))
+	}
+
 	switch {
 	case atTop && atBottom:
 		scrollIcon = " ■ " // All content visible (empty square)
@@ -86,14 +163,10 @@ func (h *DevTUI) renderFooterInput() string {
 	// Obtener el campo activo
 	tabSection := h.TabSections[h.activeTab]
 
-	// Verificar que el índice activo esté en rango
+	// activeField reinicia a 0 un índice fuera de rango
 	fieldHandlers := tabSection.fieldHandlers
-	if tabSection.indexActiveEditField >= len(fieldHandlers) {
-		tabSection.indexActiveEditField = 0 // Reiniciar a 0 si está fuera de rango
-	}
-
-	field := fieldHandlers[tabSection.indexActiveEditField]
-	info := h.renderScrollInfo()
+	field := tabSection.activeField()
+	info := h.renderFooterInfo()
 	horizontalPadding := 1
 
 	// Check if this handler uses expanded footer (Display only)
@@ -111,14 +184,14 @@ func (h *DevTUI) renderFooterInput() string {
 		fieldPagination := fmt.Sprintf("%2d/%2d", displayCurrent, displayTotal)
 		paginationStyled := h.paginationStyle.Render(fieldPagination)
 		remainingWidth := h.viewport.Width - lipgloss.Width(info) - lipgloss.Width(paginationStyled) - horizontalPadding*2
-		labelText := tinystring.Convert(field.getExpandedFooterLabel()).Truncate(remainingWidth-1, 0).String()
-		displayStyle := lipgloss.NewStyle().
+		labelText := h.truncate(field.getExpandedFooterLabel(), remainingWidth-1)
+		displayStyle := h.newStyle().
 			Width(remainingWidth).
 			Padding(0, horizontalPadding).
 			Background(lipgloss.Color(h.Secondary)).
 			Foreground(lipgloss.Color(h.Foreground))
 		styledLabel := displayStyle.Render(labelText)
-		spacerStyle := lipgloss.NewStyle().Width(horizontalPadding).Render("")
+		spacerStyle := h.newStyle().Width(horizontalPadding).Render("")
 		return lipgloss.JoinHorizontal(lipgloss.Left, paginationStyled, spacerStyle, styledLabel, spacerStyle, info)
 	}
 
@@ -155,10 +228,10 @@ func (h *DevTUI) renderFooterInput() string {
 		if textWidth < 1 {
 			textWidth = 1
 		}
-		valueText = tinystring.Convert(valueText).Truncate(textWidth, 0).String()
+		valueText = h.truncate(valueText, textWidth)
 
 		// Definir el estilo para el valor del campo (Execution: Fondo blanco con letras oscuras)
-		inputValueStyle := lipgloss.NewStyle().
+		inputValueStyle := h.newStyle().
 			Width(valueWidth).
 			Padding(0, horizontalPadding).
 			Background(lipgloss.Color(h.Foreground)).
@@ -168,7 +241,7 @@ func (h *DevTUI) renderFooterInput() string {
 		styledValue := inputValueStyle.Render(valueText)
 
 		// Crear un estilo para el espacio entre elementos
-		spacerStyle := lipgloss.NewStyle().Width(horizontalPadding).Render("")
+		spacerStyle := h.newStyle().Width(horizontalPadding).Render("")
 
 		// Layout: [Pagination] [Value expandido] [Scroll%]
 		return lipgloss.JoinHorizontal(
@@ -185,7 +258,7 @@ func (h *DevTUI) renderFooterInput() string {
 	labelWidth := h.labelWidth
 
 	// Truncar la etiqueta si es necesario
-	labelText := tinystring.Convert(field.handler.Label()).Truncate(labelWidth-1, 0).String()
+	labelText := h.truncate(field.handler.Label(), labelWidth-1)
 
 	// Aplicar el estilo base para garantizar un ancho fijo
 	fixedWidthLabel := h.labelStyle.Render(labelText)
@@ -219,6 +292,11 @@ func (h *DevTUI) renderFooterInput() string {
 	if field.tempEditValue != "" {
 		valueText = field.tempEditValue
 	}
+	// Placeholder solo se muestra con el campo vacío (no es un valor real)
+	placeholder := field.placeholderText()
+	if placeholder != "" {
+		valueText = placeholder
+	}
 
 	// Truncar el valor para que no afecte el diseño del footer
 	// Descontar el padding que se aplicará al estilo
@@ -226,7 +304,7 @@ func (h *DevTUI) renderFooterInput() string {
 	if textWidth < 1 {
 		textWidth = 1
 	}
-	valueText = tinystring.Convert(valueText).Truncate(textWidth, 0).String()
+	valueText = h.truncate(valueText, textWidth)
 
 	// Mostrar cursor solo si estamos en modo edición y el campo es editable
 	if h.editModeActivated && field.editable() {
@@ -234,16 +312,17 @@ func (h *DevTUI) renderFooterInput() string {
 	}
 
 	// Definir el estilo para el valor del campo
-	inputValueStyle := lipgloss.NewStyle().
+	inputValueStyle := h.newStyle().
 		Width(valueWidth).
 		Padding(0, horizontalPadding)
 
 	// Aplicar estilos para Edit handlers según el estado
 	if h.editModeActivated && field.editable() {
 		// Edit en modo edición activa
+		editBackground, editForeground := h.editColors()
 		inputValueStyle = inputValueStyle.
-			Background(lipgloss.Color(h.Secondary)).
-			Foreground(lipgloss.Color(h.Foreground))
+			Background(lipgloss.Color(editBackground)).
+			Foreground(lipgloss.Color(editForeground))
 	} else {
 		// Edit en modo no edición
 		inputValueStyle = inputValueStyle.
@@ -251,8 +330,17 @@ func (h *DevTUI) renderFooterInput() string {
 			Foreground(lipgloss.Color(h.Background))
 	}
 
+	if placeholder != "" {
+		inputValueStyle = inputValueStyle.Foreground(lipgloss.Color(h.Muted))
+	}
+
 	// Añadir cursor si corresponde
-	if showCursor {
+	if showCursor && placeholder != "" {
+		valueText = editCursorMark + valueText
+	} else if showCursor && field.selected {
+		// Valor seleccionado (SelectAllOnEdit): se muestra invertido, la primera tecla lo reemplaza
+		valueText = h.newStyle().Reverse(true).Render(h.truncate(field.tempEditValue, textWidth-1)) + editCursorMark
+	} else if showCursor {
 		// Asegurar que el cursor está dentro de los límites
 		runes := []rune(field.tempEditValue)
 		if field.cursor < 0 {
@@ -262,21 +350,16 @@ func (h *DevTUI) renderFooterInput() string {
 			field.cursor = len(runes)
 		}
 
-		// Insertar el cursor en la posición correcta
-		if field.cursor <= len(runes) {
-			beforeCursor := string(runes[:field.cursor])
-			afterCursor := string(runes[field.cursor:])
-			valueText = beforeCursor + "▋" + afterCursor
-		} else {
-			valueText = field.tempEditValue + "▋"
-		}
+		// Insertar el cursor en la posición correcta, mostrando solo la parte del
+		// valor alrededor del cursor que cabe en el ancho actual
+		valueText = editWindow(field.tempEditValue, field.cursor, textWidth)
 	}
 
 	// Renderizar el valor con el estilo adecuado
 	styledValue := inputValueStyle.Render(valueText)
 
 	// Crear un estilo para el espacio entre elementos
-	spacerStyle := lipgloss.NewStyle().Width(horizontalPadding).Render("")
+	spacerStyle := h.newStyle().Width(horizontalPadding).Render("")
 
 	// Layout: [Pagination] [Label] [Value] [Scroll%]
 	return lipgloss.JoinHorizontal(
@@ -0,0 +1,59 @@
+package devtui
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+func TestFooterBadgeLeftOfScrollInfo(t *testing.T) {
+	h := DefaultTUIForTest()
+	h.viewport.Width = 80
+	h.viewport.Height = 10
+
+	tab := h.NewTabSection("Server", "HTTP")
+	h.AddHandler(NewTestEditableHandler("Port", "8080"), 0, "", tab)
+	ts := tab.(*tabSection)
+	h.activeTab = ts.index
+
+	ts.SetBadge("●Connected")
+	footer := h.footerView()
+
+	badgeAt := strings.Index(footer, "●Connected")
+	scrollAt := strings.Index(footer, "■")
+	if badgeAt < 0 || scrollAt < 0 {
+		t.Fatalf("Expected badge and scroll info in footer, got %q", footer)
+	}
+	if badgeAt > scrollAt {
+		t.Errorf("Expected badge left of the scroll info, got %q", footer)
+	}
+	if w := lipgloss.Width(footer); w > h.viewport.Width {
+		t.Errorf("Footer width %d exceeds viewport width %d", w, h.viewport.Width)
+	}
+
+	ts.SetBadge("")
+	if footer := h.footerView(); strings.Contains(footer, "●Connected") {
+		t.Errorf("Expected empty badge to be hidden, got %q", footer)
+	}
+}
+
+func TestFooterBadgeTruncated(t *testing.T) {
+	h := DefaultTUIForTest()
+	h.viewport.Width = 60
+	h.viewport.Height = 10
+
+	tab := h.NewTabSection("Build", "Writers only")
+	ts := tab.(*tabSection)
+	h.activeTab = ts.index
+
+	ts.SetBadge("Building a very long pipeline name that does not fit")
+	footer := h.footerView()
+
+	if !strings.Contains(footer, h.EllipsisString) {
+		t.Errorf("Expected long badge to be truncated with %q, got %q", h.EllipsisString, footer)
+	}
+	if w := lipgloss.Width(footer); w > h.viewport.Width {
+		t.Errorf("Footer width %d exceeds viewport width %d", w, h.viewport.Width)
+	}
+}
@@ -0,0 +1,70 @@
+package devtui
+
+import (
+	"strings"
+
+	. "github.com/cdvelop/tinystring"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// Global help: F1 (or ? on a field without Help()) opens a modal box over the content
+// with the navigation keys, the fields of the active tab and the registered shortcuts.
+// The next key only closes it, so nothing leaks to handlers while it is open.
+
+// globalHelpKeys returns the navigation bindings listed at the top of the global help
+func globalHelpKeys() []string {
+	return []string{
+		"  • Tab/Shift+Tab  - switch tab",
+		"  • ←/→            - switch field",
+		"  • Enter          - edit/run field",
+		"  • Esc            - cancel edit",
+		"  • ↑/↓ PgUp/PgDn  - scroll",
+		Translate("  • e              - ", terms.Filter, ": ", D.All, "/", terms.Warnings, "/", terms.Errors).String(),
+		Translate("  • Space          - ", terms.Pause, "/", terms.Resume, terms.Output, " -", D.Tab, terms.Without, D.Fields).String(),
+		"  • ?              - field help",
+		"  • F1             - this help",
+		"  • Ctrl+C         - quit",
+	}
+}
+
+// openGlobalHelp shows the global help overlay
+func (h *DevTUI) openGlobalHelp() {
+	h.showFieldHelp = false
+	h.showGlobalHelp = true
+}
+
+// handleGlobalHelpKeyboard swallows the key that dismisses the global help.
+// Ctrl+C still quits.
+func (h *DevTUI) handleGlobalHelpKeyboard(msg tea.KeyMsg) (bool, tea.Cmd) {
+	h.showGlobalHelp = false
+	if msg.Type == tea.KeyCtrlC {
+		return h.handleNormalModeKeyboard(msg)
+	}
+	return false, nil
+}
+
+// globalHelpBox renders the global help: keys, fields of the active tab and shortcuts
+func (h *DevTUI) globalHelpBox() string {
+	sections := []string{"Keys:\n" + strings.Join(globalHelpKeys(), "\n")}
+
+	if h.activeTab < len(h.TabSections) {
+		tab := h.TabSections[h.activeTab]
+		var fields []string
+		for i, f := range tab.fieldHandlers {
+			marker := "  "
+			if i == tab.indexActiveEditField {
+				marker = "› "
+			}
+			fields = append(fields, marker+f.title())
+		}
+		if len(fields) > 0 {
+			sections = append(sections, tab.currentTitle()+" fields:\n"+strings.Join(fields, "\n"))
+		}
+	}
+
+	if lines := h.shortcutLines(); len(lines) > 0 {
+		sections = append(sections, "Shortcuts:\n"+strings.Join(lines, "\n"))
+	}
+
+	return h.renderOverlayBox(h.appTitle()+" help", strings.Join(sections, "\n\n"), "any key to close")
+}
@@ -0,0 +1,54 @@
+package devtui
+
+import (
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestGlobalHelpOverlayListsKeysFieldsAndShortcuts(t *testing.T) {
+	h, handler := setupSequenceShortcutTest(t)
+	h.windowHeight = 40
+	h.viewport.Height = 30
+	h.activeTab = len(h.TabSections) - 1
+
+	h.handleKeyboard(tea.KeyMsg{Type: tea.KeyF1})
+	if !h.showGlobalHelp {
+		t.Fatal("Expected F1 to open the global help")
+	}
+
+	frame := h.RenderFrame(true)
+	for _, want := range []string{"F1", "switch tab", "Build fields:", "› Build", "gb - go build"} {
+		if !strings.Contains(frame, want) {
+			t.Errorf("Expected global help to contain %q, got:\n%s", want, frame)
+		}
+	}
+
+	// The dismissing key is swallowed: "x" closes the help but doesn't run its shortcut
+	pressRune(h, 'x')
+	if h.showGlobalHelp {
+		t.Error("Expected any key to close the global help")
+	}
+	if len(handler.values) != 0 {
+		t.Errorf("Expected the dismissing key not to reach handlers, got %v", handler.values)
+	}
+	if strings.Contains(h.RenderFrame(true), "switch tab") {
+		t.Error("Expected the overlay to be gone after closing")
+	}
+}
+
+func TestGlobalHelpSwallowsKeysInEditMode(t *testing.T) {
+	h, field := setupTestWithEditableField(t)
+
+	h.handleKeyboard(tea.KeyMsg{Type: tea.KeyF1})
+	h.handleKeyboard(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'a'}})
+	if field.tempEditValue != "" || !h.editModeActivated {
+		t.Errorf("Expected the key closing the help not to be typed, got %q", field.tempEditValue)
+	}
+
+	h.handleKeyboard(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'a'}})
+	if field.tempEditValue != "a" {
+		t.Errorf("Expected typing to resume after closing the help, got %q", field.tempEditValue)
+	}
+}
@@ -41,8 +41,10 @@ func (t *DevTUI) validateTabSection(tab any, methodName string) *tabSection {
 //
 // Supported handler interfaces (from interfaces.go):
 //   - HandlerDisplay: Static/dynamic content display
+//   - HandlerTable: Read-only tabular data with aligned columns
 //   - HandlerEdit: Interactive text input fields
 //   - HandlerExecution: Action buttons
+//   - HandlerToggle: On/off settings flipped with Space/Enter
 //   - HandlerInteractive: Combined display + interaction
 //   - HandlerLogger: Basic line-by-line logging (via MessageTracker detection)
 //
@@ -53,7 +55,7 @@ func (t *DevTUI) validateTabSection(tab any, methodName string) *tabSection {
 // Parameters:
 //   - handler: ANY handler implementing one of the supported interfaces
 //   - timeout: Operation timeout (used for Edit/Execution/Interactive handlers, ignored for Display)
-//   - color: Hex color for handler messages (e.g., "#1e40af", empty string for default)
+//   - color: Hex color for handler messages (e.g., "#1e40af", empty string for default or TuiConfig.AutoColor)
 //   - tabSection: The tab section returned by NewTabSection (as any for decoupling)
 //
 // Example:
@@ -68,12 +70,20 @@ func (t *DevTUI) AddHandler(handler any, timeout time.Duration, color string, ta
 
 // addHandler - internal method (lowercase, private)
 func (ts *tabSection) addHandler(handler any, timeout time.Duration, color string) {
+	color = ts.tui.resolveHandlerColor(color)
+
 	// Type detection and routing
 	switch h := handler.(type) {
 
 	case HandlerDisplay:
 		ts.registerDisplayHandler(h, color)
 
+	case HandlerTable:
+		ts.registerTableHandler(h, color)
+
+	case HandlerToggle:
+		ts.registerToggleHandler(h, timeout, color)
+
 	case HandlerInteractive:
 		ts.registerInteractiveHandler(h, timeout, color)
 
@@ -121,6 +131,8 @@ func (t *DevTUI) AddLogger(name string, enableTracking bool, color string, tabSe
 
 // addLogger - internal method (lowercase, private)
 func (ts *tabSection) addLogger(name string, enableTracking bool, color string) func(message ...any) {
+	color = ts.tui.resolveHandlerColor(color)
+
 	if enableTracking {
 		handler := &simpleWriterTrackerHandler{name: name}
 		return ts.registerLoggerFunc(handler, color)
@@ -130,6 +142,88 @@ func (ts *tabSection) addLogger(name string, enableTracking bool, color string)
 	}
 }
 
+// AddBroadcastLogger creates a logger whose messages appear in its own tab and in every
+// additional tab (e.g. an aggregate "All Logs" tab). Broadcast loggers always create new lines.
+//
+// Parameters:
+//   - name: Logger identifier for message display
+//   - color: Hex color for logger messages (same color is used in every tab)
+//   - tab: The primary tab section returned by NewTabSection
+//   - alsoTo: Additional tab sections that receive a copy of each message
+//
+// Example:
+//
+//	build := tui.NewTabSection("BUILD", "Compiler")
+//	all := tui.NewTabSection("ALL", "All Logs")
+//	log := tui.AddBroadcastLogger("Compiler", "#1e40af", build, all)
+//	log("Build started") // shown in BUILD and ALL
+func (t *DevTUI) AddBroadcastLogger(name string, color string, tab any, alsoTo ...any) func(message ...any) {
+	ts := t.validateTabSection(tab, "AddBroadcastLogger")
+	others := make([]*tabSection, 0, len(alsoTo))
+	for _, other := range alsoTo {
+		others = append(others, t.validateTabSection(other, "AddBroadcastLogger"))
+	}
+	return ts.addBroadcastLogger(name, color, others...)
+}
+
+// NewBroadcastLogger creates a logger that routes each message to this tab and to alsoTo tabs.
+func (ts *tabSection) NewBroadcastLogger(name string, alsoTo ...*tabSection) func(message ...any) {
+	return ts.addBroadcastLogger(name, "", alsoTo...)
+}
+
+// addBroadcastLogger - internal method (lowercase, private)
+func (ts *tabSection) addBroadcastLogger(name string, color string, alsoTo ...*tabSection) func(message ...any) {
+	// Resolve once so the logger keeps the same color in every tab
+	color = ts.tui.resolveHandlerColor(color)
+
+	loggers := []func(message ...any){ts.addLogger(name, false, color)}
+	for _, other := range alsoTo {
+		if other == nil || other == ts {
+			continue
+		}
+		loggers = append(loggers, other.addLogger(name, false, color))
+	}
+
+	return func(message ...any) {
+		for _, log := range loggers {
+			log(message...)
+		}
+	}
+}
+
+// AddFieldLogger creates a structured logger that prints each call as space-separated
+// key=value pairs (sorted by key), with keys colored distinctly from values.
+// The message type is taken from the "level" key (error, warn, info, success).
+//
+// Parameters:
+//   - name: Logger identifier for message display
+//   - color: Hex color for logger messages (e.g., "#1e40af", empty string for default)
+//   - tabSection: The tab section returned by NewTabSection (as any for decoupling)
+//
+// Example:
+//
+//	tab := tui.NewTabSection("SERVER", "HTTP")
+//	log := tui.AddFieldLogger("HTTP", "#1e40af", tab)
+//	log(map[string]any{"level": "error", "msg": "boom", "status": 500})
+//	// => level=error msg=boom status=500
+func (t *DevTUI) AddFieldLogger(name string, color string, tabSection any) func(fields map[string]any) {
+	ts := t.validateTabSection(tabSection, "AddFieldLogger")
+	return ts.addFieldLogger(name, color)
+}
+
+// AddStructuredLogger creates a logger that prints a message followed by ordered key=value
+// pairs, with keys styled differently: log("build done", "duration", "2s", "files", 42).
+//
+// Example:
+//
+//	tab := tui.NewTabSection("BUILD", "Compiler")
+//	log := tui.AddStructuredLogger("Compiler", "", tab)
+//	log("build done", "duration", "2s", "files", 42) // build done duration=2s files=42
+func (t *DevTUI) AddStructuredLogger(name string, color string, tabSection any) func(msg string, kv ...any) {
+	ts := t.validateTabSection(tabSection, "AddStructuredLogger")
+	return ts.AddStructuredLogger(name, color)
+}
+
 // Internal registration methods (private)
 
 func (ts *tabSection) registerDisplayHandler(handler HandlerDisplay, color string) {
@@ -142,6 +236,16 @@ func (ts *tabSection) registerDisplayHandler(handler HandlerDisplay, color strin
 	ts.addFields(f)
 }
 
+func (ts *tabSection) registerTableHandler(handler HandlerTable, color string) {
+	anyH := NewTableHandler(handler, color)
+	f := &field{
+		handler:    anyH,
+		parentTab:  ts,
+		asyncState: &internalAsyncState{},
+	}
+	ts.addFields(f)
+}
+
 func (ts *tabSection) registerEditHandler(handler HandlerEdit, timeout time.Duration, color string) {
 	var tracker MessageTracker
 	if t, ok := handler.(MessageTracker); ok {
@@ -168,6 +272,20 @@ func (ts *tabSection) registerExecutionHandler(handler HandlerExecution, timeout
 		asyncState: &internalAsyncState{},
 	}
 	ts.addFields(f)
+
+	ts.registerShortcutsIfSupported(handler, len(ts.fieldHandlers)-1)
+}
+
+func (ts *tabSection) registerToggleHandler(handler HandlerToggle, timeout time.Duration, color string) {
+	anyH := NewToggleHandler(handler, timeout, color)
+	f := &field{
+		handler:    anyH,
+		parentTab:  ts,
+		asyncState: &internalAsyncState{},
+	}
+	ts.addFields(f)
+
+	ts.registerShortcutsIfSupported(handler, len(ts.fieldHandlers)-1)
 }
 
 func (ts *tabSection) registerInteractiveHandler(handler HandlerInteractive, timeout time.Duration, color string) {
@@ -183,6 +301,8 @@ func (ts *tabSection) registerInteractiveHandler(handler HandlerInteractive, tim
 		asyncState: &internalAsyncState{},
 	}
 	ts.addFields(f)
+
+	ts.registerShortcutsIfSupported(handler, len(ts.fieldHandlers)-1)
 }
 
 func (ts *tabSection) registerLoggerHandler(handler HandlerLogger, color string, hasTracking bool) {
@@ -237,8 +357,39 @@ func (w *simpleWriterTrackerHandler) SetLastOperationID(id string) {
 	w.lastOperationID = id
 }
 
+// ResetTracking makes the next message start a fresh tracked line
+func (w *simpleWriterTrackerHandler) ResetTracking() {
+	w.SetLastOperationID("")
+}
+
+// ResetTracking makes the next message of the tracked logger or handler named name start a
+// new line instead of updating its last one (eg: one line per periodic health check).
+// It reports whether a handler with that name was found.
+//
+// Example:
+//
+//	tab.(interface{ ResetTracking(string) bool }).ResetTracking("HealthCheck")
+func (ts *tabSection) ResetTracking(name string) bool {
+	if handler := ts.getWritingHandler(name); handler != nil {
+		handler.SetLastOperationID("")
+		return true
+	}
+	for _, f := range ts.fieldHandlers {
+		if f.handler != nil && f.handler.Name() == name {
+			f.handler.SetLastOperationID("")
+			return true
+		}
+	}
+	return false
+}
+
 // registerShortcutsIfSupported checks if handler implements shortcut interface and registers shortcuts
-func (ts *tabSection) registerShortcutsIfSupported(handler HandlerEdit, fieldIndex int) {
+// (edit, execution and interactive handlers)
+func (ts *tabSection) registerShortcutsIfSupported(handler interface{ Name() string }, fieldIndex int) {
+	// Detached tabs (TuiConfig.MaxTabs exceeded) are never displayed
+	if ts.index < 0 {
+		return
+	}
 	// Check if handler implements shortcut interface
 	if shortcutProvider, hasShortcuts := handler.(ShortcutProvider); hasShortcuts {
 		shortcuts := shortcutProvider.Shortcuts()
@@ -253,7 +404,14 @@ func (ts *tabSection) registerShortcutsIfSupported(handler HandlerEdit, fieldInd
 					HandlerName: handler.Name(),
 					Value:       key, // Use the key as the value by default
 				}
-				ts.tui.shortcutRegistry.Register(key, entry)
+				previous, stored := ts.tui.shortcutRegistry.registerWithPolicy(entry, ts.tui.ShortcutConflictPolicy)
+				if previous != nil && ts.tui.Logger != nil {
+					winner := previous.HandlerName
+					if stored {
+						winner = entry.HandlerName
+					}
+					ts.tui.Logger(fmt.Sprintf("Shortcut conflict: %q claimed by %s and %s, using %s", key, previous.HandlerName, entry.HandlerName, winner))
+				}
 			}
 		}
 	}
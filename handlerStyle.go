@@ -0,0 +1,59 @@
+package devtui
+
+import (
+	"time"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// HandlerStyle controls how the normal messages of one handler are rendered, independently
+// of the message type colors. Errors, warnings, info and success keep their type style.
+type HandlerStyle struct {
+	Foreground string // hex color of the message text and handler name (empty: default)
+	Background string // hex background color of the message text (empty: none)
+	Bold       bool
+}
+
+// AddHandlerStyled registers handler like AddHandler, rendering its normal messages with
+// style instead of a single name color.
+//
+// Example:
+//
+//	tui.AddHandlerStyled(myHandler, 2*time.Second, devtui.HandlerStyle{
+//		Foreground: "#FFFFFF",
+//		Background: "#1E40AF",
+//		Bold:       true,
+//	}, tab)
+func (t *DevTUI) AddHandlerStyled(handler any, timeout time.Duration, style HandlerStyle, tabSection any) {
+	ts := t.validateTabSection(tabSection, "AddHandlerStyled")
+	if named, ok := handler.(interface{ Name() string }); ok {
+		ts.setMessageStyle(named.Name(), style)
+	}
+	ts.addHandler(handler, timeout, style.Foreground)
+}
+
+// setMessageStyle stores the lipgloss style used for the normal messages of handlerName
+func (ts *tabSection) setMessageStyle(handlerName string, style HandlerStyle) {
+	s := ts.tui.newStyle().Bold(style.Bold)
+	if style.Foreground != "" {
+		s = s.Foreground(lipgloss.Color(style.Foreground))
+	}
+	if style.Background != "" {
+		s = s.Background(lipgloss.Color(style.Background))
+	}
+
+	ts.mu.Lock()
+	defer ts.mu.Unlock()
+	if ts.messageStyles == nil {
+		ts.messageStyles = make(map[string]lipgloss.Style)
+	}
+	ts.messageStyles[handlerName] = s
+}
+
+// messageStyle returns the style registered with AddHandlerStyled for handlerName
+func (ts *tabSection) messageStyle(handlerName string) (lipgloss.Style, bool) {
+	ts.mu.RLock()
+	defer ts.mu.RUnlock()
+	style, ok := ts.messageStyles[handlerName]
+	return style, ok
+}
@@ -0,0 +1,38 @@
+package devtui
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestHandlerNameSeparator(t *testing.T) {
+	cases := []struct {
+		separator string
+		want      string
+	}{
+		{"", "  Build   build started"},      // default single space after the padded name
+		{" | ", "  Build   | build started"}, // custom separator
+	}
+
+	for _, c := range cases {
+		h := NewTUI(&TuiConfig{
+			AppName:              "Separator",
+			ExitChan:             make(chan bool),
+			HandlerNameSeparator: c.separator,
+			HideTimestamps:       true,
+			Logger:               func(messages ...any) {},
+		})
+		h.SetTestMode(true)
+		h.viewport.Width = 80
+		h.viewport.Height = 10
+
+		tab := h.NewTabSection("Logs", "Separator test")
+		log := h.AddLogger("Build", false, "", tab)
+		log("build started")
+		h.activeTab = GetFirstTestTabIndex()
+
+		if got := h.ContentView(); !strings.Contains(got, c.want) {
+			t.Errorf("separator %q: expected %q in content, got %q", c.separator, c.want, got)
+		}
+	}
+}
@@ -0,0 +1,40 @@
+package devtui
+
+import (
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/x/ansi"
+)
+
+func TestCtrlNTogglesHandlerNames(t *testing.T) {
+	h := DefaultTUIForTest()
+	h.viewport.Width = 80
+	h.viewport.Height = 10
+	tab := h.NewTabSection("Logs", "Handler names toggle test")
+	log := h.AddLogger("Builder", false, "", tab)
+	ts := tab.(*tabSection)
+	h.activeTab = ts.index
+
+	log("compiled")
+	msg := ts.tabContents[0]
+
+	if got := ansi.Strip(h.formatMessage(msg)); !strings.Contains(got, "Builder") {
+		t.Fatalf("Expected handler name before the message, got %q", got)
+	}
+
+	h.handleKeyboard(tea.KeyMsg{Type: tea.KeyCtrlN})
+	got := ansi.Strip(h.formatMessage(msg))
+	if strings.Contains(got, "Builder") || !strings.Contains(got, "compiled") {
+		t.Errorf("Expected handler name hidden after Ctrl+N, got %q", got)
+	}
+	if plain := h.plainMessage(msg); strings.Contains(plain, "Builder") {
+		t.Errorf("Expected exported text to follow the toggle, got %q", plain)
+	}
+
+	h.handleKeyboard(tea.KeyMsg{Type: tea.KeyCtrlN})
+	if got := ansi.Strip(h.formatMessage(msg)); !strings.Contains(got, "Builder") {
+		t.Errorf("Expected handler name back after second Ctrl+N, got %q", got)
+	}
+}
@@ -0,0 +1,57 @@
+package devtui
+
+import (
+	"strings"
+	"testing"
+
+	. "github.com/cdvelop/tinystring"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/muesli/termenv"
+)
+
+func TestAddHandlerStyledRendersNormalMessages(t *testing.T) {
+	previous := lipgloss.ColorProfile()
+	lipgloss.SetColorProfile(termenv.TrueColor)
+	defer lipgloss.SetColorProfile(previous)
+
+	h := DefaultTUIForTest()
+	h.viewport.Width = 100
+	h.viewport.Height = 20
+	tab := h.NewTabSection("Styled", "Handler style test")
+	handler := NewTestNonEditableHandler("Deploy", "deploy")
+	style := HandlerStyle{Foreground: "#FFFFFF", Background: "#1E40AF", Bold: true}
+	h.AddHandlerStyled(handler, 0, style, tab)
+	ts := tab.(*tabSection)
+
+	got, ok := ts.messageStyle(handler.Name())
+	if !ok {
+		t.Fatal("Expected a message style registered for the handler")
+	}
+	if got.GetForeground() != lipgloss.Color("#FFFFFF") || got.GetBackground() != lipgloss.Color("#1E40AF") || !got.GetBold() {
+		t.Errorf("Unexpected registered style: fg %v bg %v bold %v", got.GetForeground(), got.GetBackground(), got.GetBold())
+	}
+	if color := ts.fieldHandlers[0].handler.handlerColor; color != "#FFFFFF" {
+		t.Errorf("Expected handler name color from Foreground, got %q", color)
+	}
+
+	h.sendMessageWithHandler("release v1.2", Msg.Normal, ts, handler.Name(), "", "#FFFFFF")
+	h.sendMessageWithHandler("deploy failed", Msg.Error, ts, handler.Name(), "", "#FFFFFF")
+
+	var normal, failed tabContent
+	for _, c := range ts.tabContents {
+		switch c.Content {
+		case "release v1.2":
+			normal = c
+		case "deploy failed":
+			failed = c
+		}
+	}
+
+	rendered := h.formatMessage(normal)
+	if want := got.Render("release v1.2"); !strings.Contains(rendered, want) {
+		t.Errorf("Expected normal message rendered with handler style %q, got %q", want, rendered)
+	}
+	if want := h.errStyle.Render("deploy failed"); !strings.Contains(h.formatMessage(failed), want) {
+		t.Error("Expected error messages to keep the error style")
+	}
+}
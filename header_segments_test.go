@@ -0,0 +1,53 @@
+package devtui
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/ansi"
+)
+
+func TestHeaderSegmentsRenderEachFrame(t *testing.T) {
+	h := DefaultTUIForTest()
+	h.viewport.Width = 80
+	h.viewport.Height = 10
+	branch := "main"
+	h.HeaderLeft = func() string { return "env: dev" }
+	h.HeaderRight = func() string { return "branch: " + branch }
+	tab := h.NewTabSection("Logs", "Header segments test")
+	h.activeTab = tab.(*tabSection).index
+
+	header := ansi.Strip(h.headerView())
+	if !strings.Contains(header, "env: dev") || !strings.Contains(header, "branch: main") {
+		t.Fatalf("Expected both segments in header, got %q", header)
+	}
+	if strings.Index(header, "branch: main") < strings.Index(header, "─") {
+		t.Errorf("Expected HeaderRight after the header line, got %q", header)
+	}
+	if w := lipgloss.Width(h.headerView()); w != h.viewport.Width {
+		t.Errorf("Expected header width %d, got %d", h.viewport.Width, w)
+	}
+
+	branch = "feature"
+	if header := ansi.Strip(h.headerView()); !strings.Contains(header, "branch: feature") {
+		t.Errorf("Expected HeaderRight to be read on every render, got %q", header)
+	}
+}
+
+func TestHeaderSegmentTruncatedToFreeWidth(t *testing.T) {
+	h := DefaultTUIForTest()
+	h.viewport.Width = 40
+	h.viewport.Height = 10
+	h.HeaderRight = func() string { return strings.Repeat("x", 100) + "\nsecond line" }
+	tab := h.NewTabSection("Logs", "Header segments test")
+	h.activeTab = tab.(*tabSection).index
+
+	header := h.headerView()
+	if w := lipgloss.Width(header); w > h.viewport.Width {
+		t.Errorf("Expected header to fit %d columns, got %d: %q", h.viewport.Width, w, ansi.Strip(header))
+	}
+	if strings.Contains(header, "second line") || lipgloss.Height(header) != 1 {
+		t.Errorf("Expected only the first line of the segment, got %q", ansi.Strip(header))
+	}
+}
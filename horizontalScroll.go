@@ -0,0 +1,22 @@
+package devtui
+
+import (
+	"strings"
+
+	"github.com/charmbracelet/x/ansi"
+)
+
+// scrollHorizontal shifts the content delta columns (Shift+Left/Shift+Right) when
+// TuiConfig.HorizontalScrollStep enables horizontal scrolling. The offset is clamped
+// so the widest line still ends at the right edge of the viewport.
+func (h *DevTUI) scrollHorizontal(delta int) {
+	if h.HorizontalScrollStep <= 0 {
+		return
+	}
+	longest := 0
+	for _, line := range strings.Split(h.ContentView(), "\n") {
+		longest = max(longest, ansi.StringWidth(line))
+	}
+	h.xOffset = min(max(h.xOffset+delta, 0), max(longest-h.viewport.Width, 0))
+	h.viewport.SetXOffset(h.xOffset)
+}
@@ -0,0 +1,89 @@
+package devtui
+
+import (
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/x/ansi"
+)
+
+// wideLineHandler displays a single line wider than the viewport
+type wideLineHandler struct{ line string }
+
+func (w *wideLineHandler) Name() string    { return "Wide" }
+func (w *wideLineHandler) Content() string { return w.line }
+
+func TestShiftArrowsScrollHorizontally(t *testing.T) {
+	// 200 columns: "é123456789" repeated, the é is multi-byte but one column wide
+	line := strings.Repeat("é123456789", 20)
+	h := DefaultTUIForTest()
+	h.HorizontalScrollStep = 10
+	h.viewport.Width = 80
+	h.viewport.Height = 10
+	tab := h.NewTabSection("Wide", "Horizontal scroll test")
+	h.AddHandler(&wideLineHandler{line: line}, 0, "", tab)
+	h.activeTab = tab.(*tabSection).index
+	h.updateViewport()
+
+	// The rendered line has one column of padding on each side
+	padded := []rune(" " + line + " ")
+	visible := func() string {
+		return ansi.Strip(strings.Split(h.viewport.View(), "\n")[0])
+	}
+	window := func(offset int) string { return string(padded[offset : offset+80]) }
+
+	if got := visible(); got != window(0) {
+		t.Fatalf("Expected the first 80 columns, got %q", got)
+	}
+
+	h.handleKeyboard(tea.KeyMsg{Type: tea.KeyShiftRight})
+	if got := visible(); got != window(10) {
+		t.Errorf("Expected the window shifted 10 columns, got %q", got)
+	}
+
+	// Clamped at the end of the widest line (202 - 80 = 122 columns)
+	for i := 0; i < 20; i++ {
+		h.handleKeyboard(tea.KeyMsg{Type: tea.KeyShiftRight})
+	}
+	if got := visible(); got != window(122) {
+		t.Errorf("Expected the window clamped at column 122, got %q", got)
+	}
+
+	for i := 0; i < 20; i++ {
+		h.handleKeyboard(tea.KeyMsg{Type: tea.KeyShiftLeft})
+	}
+	if got := visible(); got != window(0) {
+		t.Errorf("Expected the window back at column 0, got %q", got)
+	}
+}
+
+func TestHorizontalScrollDisabledByDefault(t *testing.T) {
+	h := DefaultTUIForTest()
+	h.viewport.Width = 80
+	h.viewport.Height = 10
+	tab := h.NewTabSection("Wide", "Horizontal scroll test")
+	h.AddHandler(&wideLineHandler{line: strings.Repeat("x", 200)}, 0, "", tab)
+	h.activeTab = tab.(*tabSection).index
+	h.updateViewport()
+
+	before := h.viewport.View()
+	h.handleKeyboard(tea.KeyMsg{Type: tea.KeyShiftRight})
+	if h.viewport.View() != before || h.xOffset != 0 {
+		t.Error("Expected Shift+Right to do nothing without HorizontalScrollStep")
+	}
+}
+
+func TestWideTablesKeptWhileHorizontalScrolling(t *testing.T) {
+	h := DefaultTUIForTest()
+	h.viewport.Width = 40
+	row := []string{strings.Repeat("a", 30), strings.Repeat("b", 30)}
+
+	if got := h.renderTable(nil, [][]string{row}); ansi.StringWidth(got) > 40 {
+		t.Fatalf("Expected tables truncated by default, got width %d", ansi.StringWidth(got))
+	}
+	h.HorizontalScrollStep = 8
+	if got := h.renderTable(nil, [][]string{row}); ansi.StringWidth(got) != 62 {
+		t.Errorf("Expected the full 62-column row with horizontal scrolling, got width %d", ansi.StringWidth(got))
+	}
+}
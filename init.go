@@ -2,9 +2,12 @@ package devtui
 
 import (
 	"fmt"
+	"os"
 	"sync"
+	"sync/atomic"
 	"time"
 
+	. "github.com/cdvelop/tinystring"
 	"github.com/cdvelop/tinytime"
 	"github.com/cdvelop/unixid"
 	"github.com/charmbracelet/bubbles/viewport"
@@ -14,6 +17,9 @@ import (
 // channelMsg es un tipo especial para mensajes del canal
 type channelMsg tabContent
 
+// channelBatchMsg groups channel messages received within TuiConfig.WriteCoalesceInterval
+type channelBatchMsg []tabContent
+
 // Print representa un mensaje de actualización
 type tickMsg time.Time
 
@@ -31,22 +37,44 @@ type DevTUI struct {
 	focused bool // is the app focused
 
 	TabSections       []*tabSection // represent sections in the tui
-	activeTab         int           // current tab index
+	activeTab         int           // current tab index (written under activeMu)
+	activeMu          sync.RWMutex  // guards activeTab writes against PrintTyped from other goroutines
 	editModeActivated bool          // global flag to edit config
 
-	shortcutRegistry *ShortcutRegistry // NEW: Global shortcut key registry
+	shortcutRegistry *ShortcutRegistry  // NEW: Global shortcut key registry
+	autoColorIndex   int                // next color from autoHandlerColors when AutoColor is enabled
+	showTimestamps   bool               // render message timestamps (toggle with Ctrl+T)
+	hideHandlerNames bool               // omit handler names before messages (toggle with Ctrl+N)
+	now              func() time.Time   // clock used for relative timestamps (stubbed in tests)
+	clipboard        func(string) error // clipboard writer for CopyTabContent (OSC 52 by default)
+	pendingClear     bool               // Ctrl+L waiting for y/n (TuiConfig.ConfirmClear)
+	showFieldHelp    bool               // ? opened the Help() of the active field
+	showGlobalHelp   bool               // F1 help overlay open (next key closes it)
+	windowHeight     int                // terminal height from the last WindowSizeMsg
+	windowWidth      int                // terminal width from the last WindowSizeMsg
+	xOffset          int                // horizontal scroll in columns (TuiConfig.HorizontalScrollStep)
+	viewportTab      int                // tab index whose content the viewport shows
+	newMessagesBelow int                // messages arrived while scrolled up (footer indicator)
+	seenMessages     int                // active tab message count when the view last followed
+	focusedField     *field             // field that last received OnFocus (FocusListener)
+	pendingKeys      string             // typed start of a multi-key shortcut (eg: "g" of "gb")
+	pendingKeysID    int                // id of the latest shortcut sequence timeout
 
 	currentTime     string
 	tabContentsChan chan tabContent
 	tea             *tea.Program
 	testMode        bool // private: only used in tests to enable synchronous behavior
+
+	running  atomic.Bool  // tea program is running (set by Start)
+	exitOnce sync.Once    // guards ExitChan against double close (Ctrl+C and Stop)
+	titleMu  sync.RWMutex // guards AppName against SetTitle while rendering
 }
 
 type TuiConfig struct {
 	AppName  string    // app name eg: "MyApp"
 	ExitChan chan bool //  global chan to close app eg: make(chan bool)
 	/*// *ColorPalette style for the TUI
-	  // if nil it will use default style:
+	  // if nil it will use DefaultPalette (dark terminal background) or LightPalette (light background):
 	type ColorPalette struct {
 	 Foreground string // eg: #F4F4F4
 	 Background string // eg: #000000
@@ -55,6 +83,154 @@ type TuiConfig struct {
 	}*/
 	Color *ColorPalette
 
+	// MessageColors overrides the hex color used for each MessageType
+	// eg: map[MessageType]string{Msg.Error: "#FF5555", Msg.Normal: "#CCCCCC"}.
+	// Types without an entry keep the palette colors (Msg.Normal stays uncolored).
+	MessageColors map[MessageType]string
+
+	// MessageIcons prefixes message content with a glyph per MessageType, so severities
+	// stay distinguishable without color (e.g. DefaultMessageIcons()). Nil: no icons.
+	MessageIcons map[MessageType]string
+
+	// AutoColor assigns a distinct color to handlers registered without one,
+	// cycling through autoHandlerColors in registration order.
+	AutoColor bool
+
+	// HideTimestamps starts the TUI without message timestamps (default: shown).
+	// Timestamps can be toggled at runtime with Ctrl+T.
+	HideTimestamps bool
+
+	// TimestampFormat is the Go time layout for message timestamps
+	// (default "15:04:05", e.g. "15:04:05.000" for milliseconds).
+	TimestampFormat string
+
+	// TimestampMode selects absolute clock times (default) or relative ages like "5s", "2m".
+	TimestampMode TimestampMode
+
+	// Now is the clock used for message timestamps and relative ages (default: real clock).
+	// Tests can pin it for deterministic output. Message IDs stay unique either way.
+	Now func() time.Time
+
+	// ShowScrollbar draws a vertical scrollbar on the right edge of the content
+	// area when messages exceed the viewport height (uses one column).
+	ShowScrollbar bool
+
+	// TabTraversal makes Tab in edit mode save the field and move to the next one,
+	// continuing on the first field of the next tab after the last field.
+	TabTraversal bool
+
+	// MaxTabs limits the number of tabs, including the built-in SHORTCUTS tab (0 = no limit).
+	// Extra NewTabSection calls are logged and return a detached tab that is never displayed.
+	MaxTabs int
+
+	// CoalesceMessages collapses consecutive identical messages (same handler, type and
+	// content) into one line with a "(xN)" counter. Default for new tabs; see SetTabCoalescing.
+	CoalesceMessages bool
+
+	// MultilineGuides prefixes continuation lines of multi-line messages with a
+	// vertical guide ("│ ") so each message block stays visually grouped.
+	MultilineGuides bool
+
+	// LineWrap lays out content lines wider than the viewport: LineWrapOn word wraps
+	// (default), LineWrapTruncate cuts them with EllipsisString (kept whole for
+	// HorizontalScrollStep) and LineWrapIndent also aligns the lines of multi-line
+	// messages (eg: stack traces) under the content column after timestamp and handler.
+	LineWrap LineWrap
+
+	// OverflowPolicy decides what happens when the UI update channel (100 pending
+	// updates) is full: block the producer (default), drop the oldest or the newest
+	// update. Messages are always kept in the tab; dropping only skips redraw
+	// notifications, and the active tab is redrawn on the next tick instead. No
+	// "N messages dropped" notice is emitted, as nothing is lost.
+	OverflowPolicy OverflowPolicy
+
+	// AlwaysFollow scrolls to the newest message on every update, even when the user
+	// scrolled up (default: stay in place and show a "↓ N new" indicator in the footer;
+	// End jumps to the newest message).
+	AlwaysFollow bool
+
+	// NewMessagesFormat is the footer indicator of messages arrived below while scrolled
+	// up, formatted with their count (default "↓ %d new").
+	NewMessagesFormat string
+
+	// ShowKeyHints adds a footer line with the bindings of the active field
+	// eg: "Enter: edit • ←/→: fields" or "Enter: run • ←/→: fields".
+	ShowKeyHints bool
+
+	// ConfirmClear asks "Clear All Messages? y/n" (translated) before Ctrl+L clears the active tab.
+	ConfirmClear bool
+
+	// LongRunningWarning emits "operation running long (no timeout configured)" when a
+	// handler without timeout is still running after this duration (0 = off).
+	LongRunningWarning time.Duration
+
+	// ShortcutConflictPolicy decides which handler keeps a shortcut key claimed by
+	// several handlers: ShortcutLastWins (default) or ShortcutFirstWins.
+	// Conflicts are always reported through Logger.
+	ShortcutConflictPolicy ShortcutConflictPolicy
+
+	// WarnFieldIndexClamp logs through Logger whenever an out-of-range active field
+	// index is reset to the first field (useful while debugging custom navigation).
+	WarnFieldIndexClamp bool
+
+	// CloseTimeout bounds the Close() calls of handlers implementing Closer on exit
+	// (default 2s); handlers still closing after it are abandoned.
+	CloseTimeout time.Duration
+
+	// HandlerNameSeparator goes between the handler name and the message content
+	// eg: ": " or " | " (default " ").
+	HandlerNameSeparator string
+
+	// EllipsisString marks truncated labels, values and table rows (default "…").
+	EllipsisString string
+
+	// WriteCoalesceInterval buffers messages arriving within this window and renders
+	// them with a single viewport update (0 = off, every message redraws).
+	// Useful for high-frequency writers; e.g. 50 * time.Millisecond.
+	WriteCoalesceInterval time.Duration
+
+	// ForceColorProfile overrides the detected terminal color support:
+	// "truecolor", "256", "16" or "ascii" (default "": detect from COLORTERM/TERM).
+	// Hex colors are mapped to the nearest color the profile supports.
+	ForceColorProfile string
+
+	// NoColor renders without any ANSI escape sequences (plain text layout with
+	// timestamps and handler names kept). Also enabled by the NO_COLOR env var.
+	// Takes precedence over ForceColorProfile.
+	NoColor bool
+
+	// KeepBlankMessages renders empty / whitespace-only messages as blank lines
+	// (default false: loggers, writers and handler progress drop them).
+	KeepBlankMessages bool
+
+	// HeaderLeft and HeaderRight add context segments to the header, called on every
+	// render: HeaderLeft after the title, HeaderRight right-aligned before the tab
+	// pagination. eg: HeaderRight: func() string { return "branch: " + gitBranch() }.
+	// Only the first line is shown, truncated to the free header width (nil = omitted).
+	HeaderLeft  func() string
+	HeaderRight func() string
+
+	// MinWidth and MinHeight are the smallest terminal size the layout is drawn at
+	// (default 30x6); below it a centered "Terminal too small" message is shown
+	// until the window is resized.
+	MinWidth  int
+	MinHeight int
+
+	// HorizontalScrollStep enables Shift+Left / Shift+Right to scroll content wider than
+	// the viewport (eg: long Content() lines or wide tables) by this many columns.
+	// Tables are no longer truncated to the viewport width while enabled (0 = off).
+	HorizontalScrollStep int
+
+	// SelectAllOnEdit selects the whole value when a field enters edit mode: the first
+	// typed character replaces it, any other key (eg: arrows) cancels the selection.
+	// Edit handlers can override it per field with an optional SelectAllOnEdit() bool.
+	SelectAllOnEdit bool
+
+	// OnTabChange is called after the active tab changes (Tab, Shift+Tab, SetActiveTab,
+	// shortcuts or field traversal into another tab), eg: to load data lazily when a tab
+	// becomes visible. Not called for the initial tab (nil = no callback).
+	OnTabChange func(oldIndex, newIndex int)
+
 	Logger func(messages ...any) // function to write log error
 }
 
@@ -73,6 +249,35 @@ func NewTUI(c *TuiConfig) *DevTUI {
 	if c.AppName == "" {
 		c.AppName = "DevTUI"
 	}
+	if c.TimestampFormat == "" {
+		c.TimestampFormat = defaultTimestampFormat
+	}
+	if c.EllipsisString == "" {
+		c.EllipsisString = defaultEllipsis
+	}
+	if c.CloseTimeout <= 0 {
+		c.CloseTimeout = defaultCloseTimeout
+	}
+	if c.NewMessagesFormat == "" {
+		c.NewMessagesFormat = defaultNewMessagesFormat
+	}
+	if c.MinWidth <= 0 {
+		c.MinWidth = defaultMinWidth
+	}
+	if c.MinHeight <= 0 {
+		c.MinHeight = defaultMinHeight
+	}
+	if c.HandlerNameSeparator == "" {
+		c.HandlerNameSeparator = defaultHandlerNameSeparator
+	}
+
+	if os.Getenv("NO_COLOR") != "" {
+		c.NoColor = true
+	}
+	renderer := profileRenderer(c.ForceColorProfile)
+	if c.NoColor {
+		renderer = newNoColorRenderer()
+	}
 
 	// Initialize the unique ID generator first
 	id, err := unixid.NewUnixID()
@@ -94,9 +299,16 @@ func NewTUI(c *TuiConfig) *DevTUI {
 		activeTab:        0, // Will be adjusted in Start() method
 		tabContentsChan:  make(chan tabContent, 100),
 		currentTime:      time.Now().Format("15:04:05"),
-		tuiStyle:         newTuiStyle(c.Color),
+		tuiStyle:         newTuiStyleWithRenderer(renderer, c.Color, c.MessageColors),
 		id:               id,                    // Set the ID here
 		shortcutRegistry: newShortcutRegistry(), // NEW: Initialize shortcut registry
+		showTimestamps:   !c.HideTimestamps,
+		now:              time.Now,
+		clipboard:        writeClipboard,
+	}
+
+	if c.Now != nil {
+		tui.now = c.Now
 	}
 
 	// Always add SHORTCUTS tab first
@@ -116,6 +328,7 @@ func NewTUI(c *TuiConfig) *DevTUI {
 
 // Init initializes the terminal UI application.
 func (h *DevTUI) Init() tea.Cmd {
+	h.syncFocus() // initial field receives OnFocus
 	return tea.Batch(
 		tea.EnterAltScreen,
 		h.listenToMessages(),
@@ -147,12 +360,15 @@ func (h *DevTUI) Start(args ...any) {
 
 	// Start with tab 1 (skip SHORTCUTS which is at index 0) if there are multiple tabs
 	if len(h.TabSections) > 1 {
-		h.activeTab = 1
+		h.setActiveIndex(1)
 	}
 
 	// NEW: Trigger initial content display for interactive handlers after setting initial tab
 	h.checkAndTriggerInteractiveContent()
 
+	h.running.Store(true)
+	defer h.running.Store(false)
+
 	if _, err := h.tea.Run(); err != nil {
 		fmt.Println("Error running DevTUI:", err)
 		fmt.Println("\nPress any key to exit...")
@@ -161,6 +377,60 @@ func (h *DevTUI) Start(args ...any) {
 	}
 }
 
+// StartAsync runs the terminal UI in its own goroutine and returns immediately, for apps
+// managing their own lifecycle (no WaitGroup needed).
+//
+// The returned stop function closes ExitChan, quits the tea program and blocks until it has
+// fully exited. It is safe to call more than once and after the user pressed Ctrl+C.
+//
+//	stop := tui.StartAsync()
+//	defer stop()
+func (h *DevTUI) StartAsync() (stop func()) {
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		h.Start()
+	}()
+
+	var stopOnce sync.Once
+	return func() {
+		stopOnce.Do(func() {
+			h.closeExitChan()
+			// Unlike Stop, always send Quit: the program may not be running yet.
+			// Send returns once the program exited, so this goroutine never leaks.
+			go h.tea.Send(tea.Sequence(tea.ExitAltScreen, tea.Quit)())
+			<-done
+		})
+	}
+}
+
+// Stop gracefully shuts down the TUI from application code: it closes ExitChan
+// and asks the running tea program to quit, same as pressing Ctrl+C.
+//
+// Safe to call from any goroutine and more than once.
+func (h *DevTUI) Stop() {
+	h.closeExitChan()
+	if h.tea != nil && h.running.Load() {
+		// Send blocks until the event loop receives it; never block the caller
+		go h.tea.Send(tea.Sequence(tea.ExitAltScreen, tea.Quit)())
+	}
+}
+
+// closeExitChan closes handlers (Closer) and ExitChan exactly once, also when the
+// host app already closed it
+func (h *DevTUI) closeExitChan() {
+	h.exitOnce.Do(func() {
+		h.closeHandlers()
+		if h.ExitChan == nil {
+			return
+		}
+		// never receive from the app's channel to detect closure: that would consume
+		// a value it sent. Closing an already closed channel panics, recover from it.
+		defer func() { recover() }()
+		close(h.ExitChan)
+	})
+}
+
 // SetTestMode enables or disables test mode for synchronous behavior in tests.
 // This should only be used in test files to make tests deterministic.
 func (h *DevTUI) SetTestMode(enabled bool) {
@@ -1,6 +1,9 @@
 package devtui
 
-import "github.com/charmbracelet/lipgloss"
+import (
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/ansi"
+)
 
 // calculateInputWidths calculates the width available for text input based on viewport and other elements
 // Returns valueWidth (total width for the input area) and availableTextWidth (width for the text itself)
@@ -22,3 +25,38 @@ func (h *DevTUI) calculateInputWidths(fieldLabel string) (valueWidth, availableT
 
 	return valueWidth, availableTextWidth
 }
+
+// editCursorMark marks the cursor position in the footer value while editing
+const editCursorMark = "▋"
+
+// editWindow returns value with the cursor mark, cut to the part around cursor that fits
+// width columns, so the cursor stays visible in long values and after a resize
+func editWindow(value string, cursor, width int) string {
+	runes := []rune(value)
+	cursor = min(max(cursor, 0), len(runes))
+	text := string(runes[:cursor]) + editCursorMark + string(runes[cursor:])
+	if width < 1 || ansi.StringWidth(text) <= width {
+		return text
+	}
+	// Text before the cursor that fits, then as much text after it as fits
+	start := 0
+	for start < cursor && ansi.StringWidth(string(runes[start:cursor]))+1 > width {
+		start++
+	}
+	end := cursor
+	for end < len(runes) && ansi.StringWidth(string(runes[start:end+1]))+1 <= width {
+		end++
+	}
+	return string(runes[start:cursor]) + editCursorMark + string(runes[cursor:end])
+}
+
+// clampEditCursor keeps the cursor of the field being edited within its value
+// (called on resize, before the footer recomputes its widths)
+func (h *DevTUI) clampEditCursor() {
+	if !h.editModeActivated || h.activeTab >= len(h.TabSections) {
+		return
+	}
+	if f := h.TabSections[h.activeTab].activeField(); f != nil {
+		f.cursor = min(max(f.cursor, 0), len([]rune(f.tempEditValue)))
+	}
+}
@@ -7,6 +7,15 @@ type HandlerDisplay interface {
 	Content() string // Display content (e.g., "help\n1-..\n2-...", "executing deploy wait...")
 }
 
+// HandlerTable defines the interface for read-only tabular data display handlers.
+// Columns are aligned automatically and ragged rows are padded.
+// Optional: implement Headers() []string for column titles (e.g., "Service", "Status", "Uptime"),
+// rendered above the rows with an underline.
+type HandlerTable interface {
+	Name() string     // Full text to display in footer eg. "Services Status"
+	Rows() [][]string // Table rows, re-read every time the field is selected
+}
+
 // HandlerEdit defines the interface for interactive fields that accept user input.
 // These handlers allow users to modify values through text input.
 type HandlerEdit interface {
@@ -16,6 +25,19 @@ type HandlerEdit interface {
 	Change(newValue string, progress chan<- string) // Handle user input + content display via progress
 }
 
+// HandlerFilePicker is a HandlerEdit whose value is a filesystem path.
+// Tab in edit mode completes the path, cycling the candidates on repeated Tab.
+type HandlerFilePicker interface {
+	HandlerEdit
+	FileSystem() DirReader // Directory listing used for completion; nil reads the real filesystem
+}
+
+// Completer is an optional HandlerEdit extension for fields with known candidate values.
+// Tab in edit mode completes the value, cycling the matches on repeated Tab.
+type Completer interface {
+	Complete(prefix string) []string // Candidates for the typed value (e.g. "pr" -> "prod", "preview"); none: no completion
+}
+
 // HandlerExecution defines the interface for action buttons that execute operations.
 // These handlers trigger business logic when activated by the user.
 type HandlerExecution interface {
@@ -24,6 +46,15 @@ type HandlerExecution interface {
 	Execute(progress chan<- string) // Execute action + content display via progress
 }
 
+// HandlerToggle defines the interface for on/off settings.
+// The footer shows the state as [x]/[ ] and Space or Enter flips it immediately.
+type HandlerToggle interface {
+	Name() string                                // Identifier for logging: "Verbose", "AutoReload"
+	Label() string                               // Field label (e.g., "Verbose Output")
+	Current() bool                               // Current state
+	Change(newValue bool, progress func(...any)) // Handle the flipped state + content display via progress
+}
+
 // HandlerLogger defines the interface for basic writers that create new lines for each write.
 // These writers are suitable for simple logging or output display.
 type HandlerLogger interface {
@@ -49,13 +80,18 @@ type HandlerInteractive interface {
 
 // MessageTracker provides optional interface for message tracking control.
 // Handlers can implement this to control message updates and operation tracking.
+// While GetLastOperationID returns an ID, new messages update that line; calling
+// SetLastOperationID("") makes the next message start a fresh tracked line
+// (eg: one line per periodic health check).
 type MessageTracker interface {
 	GetLastOperationID() string
 	SetLastOperationID(id string)
 }
 
 // ShortcutProvider defines the optional interface for handlers that provide global shortcuts.
-// HandlerEdit implementations can implement this interface to enable global shortcut keys.
+// HandlerEdit, HandlerInteractive and HandlerExecution implementations can implement this
+// interface to enable global shortcut keys. Edit and interactive handlers receive the key as
+// the new value in Change(); execution handlers run Execute() as if Enter was pressed.
 type ShortcutProvider interface {
 	Shortcuts() []map[string]string // Returns ordered list of single-entry maps with shortcut->description, preserving registration order
 }
@@ -0,0 +1,61 @@
+package devtui
+
+import (
+	"strings"
+
+	. "github.com/cdvelop/tinystring"
+)
+
+// keyHintSeparator joins the bindings of the footer hint line
+const keyHintSeparator = " • "
+
+// keyHints returns the bindings relevant to the active field and mode for the
+// footer hint line (TuiConfig.ShowKeyHints).
+func (h *DevTUI) keyHints() string {
+	if h.activeTab >= len(h.TabSections) {
+		return ""
+	}
+	if h.pendingClear {
+		return strings.Join([]string{"y: clear", "n: cancel"}, keyHintSeparator)
+	}
+
+	tab := h.TabSections[h.activeTab]
+	field := tab.activeField()
+	if field == nil {
+		hints := []string{"↑/↓: scroll"}
+		if len(tab.fieldHandlers) == 0 { // writer-only tab: Space pauses the output
+			if paused, _ := tab.pauseState(); paused {
+				hints = append(hints, Translate("Space: ", terms.Resume).String())
+			} else {
+				hints = append(hints, Translate("Space: ", terms.Pause).String())
+			}
+		}
+		return strings.Join(append(hints, "Tab: next tab"), keyHintSeparator)
+	}
+
+	var hints []string
+	switch {
+	case h.editModeActivated && field.isInteractiveHandler():
+		hints = []string{"Enter: send", "Esc: close"}
+	case h.editModeActivated && field.completion != nil:
+		hints = []string{"Tab: " + strings.Join(field.completion.remaining(), " "), "Enter: save", "Esc: cancel"}
+	case h.editModeActivated && field.completes():
+		hints = []string{"Tab: complete", "Enter: save", "Esc: cancel", "Ctrl+Z: undo"}
+	case h.editModeActivated:
+		hints = []string{"Enter: save", "Esc: cancel", "Ctrl+Z: undo"}
+	case field.isInteractiveHandler():
+		hints = []string{"Enter: open", "←/→: fields"}
+	case field.isToggleHandler():
+		hints = []string{"Space: toggle", "←/→: fields"}
+	case field.isExecutionHandler():
+		hints = []string{"Enter: run", "←/→: fields"}
+	case field.editable():
+		hints = []string{"Enter: edit", "←/→: fields"}
+	default:
+		hints = []string{"↑/↓: scroll", "←/→: fields"}
+	}
+	if !h.editModeActivated && field.helpText() != "" {
+		hints = append(hints, Translate("?: ", terms.Help).String())
+	}
+	return strings.Join(append(hints, "Tab: next tab"), keyHintSeparator)
+}
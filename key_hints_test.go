@@ -0,0 +1,57 @@
+package devtui
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestKeyHintsFollowActiveField(t *testing.T) {
+	h := DefaultTUIForTest()
+	h.ShowKeyHints = true
+	h.viewport.Width = 80
+	h.viewport.Height = 10
+
+	tab := h.NewTabSection("Hints", "Key hints test")
+	h.AddHandler(NewTestEditableHandler("Name", "value"), 0, "", tab)
+	h.AddHandler(NewTestNonEditableHandler("Deploy", "Run"), 0, "", tab)
+	h.activeTab = GetFirstTestTabIndex()
+	ts := h.TabSections[h.activeTab]
+
+	ts.indexActiveEditField = 0
+	editHints := h.keyHints()
+	if !strings.Contains(editHints, "Enter: edit") {
+		t.Errorf("Expected edit field hints to mention editing, got %q", editHints)
+	}
+
+	h.editModeActivated = true
+	editingHints := h.keyHints()
+	if !strings.Contains(editingHints, "Esc: cancel") {
+		t.Errorf("Expected edit mode hints to mention cancel, got %q", editingHints)
+	}
+	h.editModeActivated = false
+
+	ts.indexActiveEditField = 1
+	execHints := h.keyHints()
+	if !strings.Contains(execHints, "Enter: run") {
+		t.Errorf("Expected execution field hints to mention run, got %q", execHints)
+	}
+	if execHints == editHints {
+		t.Errorf("Expected different hints for edit and execution fields, both %q", execHints)
+	}
+
+	if footer := h.footerView(); !strings.Contains(footer, "Enter: run") {
+		t.Errorf("Expected footer to show the hint line, got %q", footer)
+	}
+}
+
+func TestKeyHintsHiddenByDefault(t *testing.T) {
+	h := DefaultTUIForTest()
+	h.viewport.Width = 80
+	tab := h.NewTabSection("Hints", "Key hints test")
+	h.AddHandler(NewTestNonEditableHandler("Deploy", "Run"), 0, "", tab)
+	h.activeTab = GetFirstTestTabIndex()
+
+	if footer := h.footerView(); strings.Contains(footer, "Enter: run") {
+		t.Errorf("Expected no hint line without ShowKeyHints, got %q", footer)
+	}
+}
@@ -0,0 +1,22 @@
+package devtui
+
+// LineWrap selects how ContentView lays out message lines wider than the viewport
+type LineWrap string
+
+const (
+	LineWrapOn       LineWrap = "wrap"        // word wrap; wrapped segments align under the content (default)
+	LineWrapTruncate LineWrap = "truncate"    // one row per line, cut with TuiConfig.EllipsisString
+	LineWrapIndent   LineWrap = "wrap-indent" // word wrap; every continuation line aligns under the content
+)
+
+// layoutLine splits one content line into the rows it takes at width columns.
+// Truncated lines are kept whole when horizontal scrolling can reveal the rest.
+func (t *DevTUI) layoutLine(line string, width int) []string {
+	if t.LineWrap != LineWrapTruncate {
+		return wordWrap(line, width)
+	}
+	if t.HorizontalScrollStep > 0 {
+		return []string{line}
+	}
+	return []string{t.truncate(line, width)}
+}
@@ -0,0 +1,75 @@
+package devtui
+
+import (
+	"strings"
+	"testing"
+
+	. "github.com/cdvelop/tinystring"
+	"github.com/charmbracelet/x/ansi"
+)
+
+func setupLineWrapTest(t *testing.T, mode LineWrap, scrollStep int) (*DevTUI, *tabSection) {
+	t.Helper()
+	h := NewTUI(&TuiConfig{
+		AppName:              "Wrap",
+		ExitChan:             make(chan bool),
+		Logger:               func(...any) {},
+		HideTimestamps:       true,
+		LineWrap:             mode,
+		HorizontalScrollStep: scrollStep,
+	})
+	h.SetTestMode(true)
+	h.viewport.Width = 40
+	tab := h.NewTabSection("Logs", "Line wrap test")
+	h.AddLogger("App", false, "", tab)
+	return h, tab.(*tabSection)
+}
+
+func formattedLines(h *DevTUI, ts *tabSection) []string {
+	return strings.Split(ansi.Strip(h.formatMessage(ts.tabContents[0])), "\n")
+}
+
+func TestLineWrapTruncateCutsWithEllipsis(t *testing.T) {
+	h, ts := setupLineWrapTest(t, LineWrapTruncate, 0)
+	h.sendMessageWithHandler(strings.Repeat("word ", 20), Msg.Normal, ts, "App", "", "")
+
+	lines := formattedLines(h, ts)
+	if len(lines) != 1 {
+		t.Fatalf("Expected one row in truncate mode, got %d: %q", len(lines), lines)
+	}
+	if !strings.HasSuffix(lines[0], h.EllipsisString) || ansi.StringWidth(lines[0]) > h.viewport.Width {
+		t.Errorf("Expected the line cut to the viewport with an ellipsis, got %q", lines[0])
+	}
+}
+
+func TestLineWrapTruncateKeepsLineForHorizontalScroll(t *testing.T) {
+	h, ts := setupLineWrapTest(t, LineWrapTruncate, 4)
+	long := strings.Repeat("word ", 20)
+	h.sendMessageWithHandler(long, Msg.Normal, ts, "App", "", "")
+
+	lines := formattedLines(h, ts)
+	if len(lines) != 1 || !strings.Contains(lines[0], strings.TrimSpace(long)) {
+		t.Errorf("Expected the whole line on one row for horizontal scrolling, got %q", lines)
+	}
+}
+
+func TestLineWrapIndentAlignsMultiLineMessages(t *testing.T) {
+	trace := "panic: boom\ngoroutine 1 [running]\nmain.main()"
+
+	h, ts := setupLineWrapTest(t, LineWrapIndent, 0)
+	h.sendMessageWithHandler(trace, Msg.Normal, ts, "App", "", "")
+	lines := formattedLines(h, ts)
+	column := strings.Index(lines[0], "panic")
+	for _, line := range lines[1:] {
+		if got := len(line) - len(strings.TrimLeft(line, " ")); got != column {
+			t.Errorf("Expected continuation line aligned at column %d, got %d in %q", column, got, line)
+		}
+	}
+
+	// Default "wrap" keeps the extra lines at the left edge
+	h, ts = setupLineWrapTest(t, "", 0)
+	h.sendMessageWithHandler(trace, Msg.Normal, ts, "App", "", "")
+	if lines := formattedLines(h, ts); !strings.HasPrefix(lines[1], "goroutine") {
+		t.Errorf("Expected default wrap to leave multi-line messages unindented, got %q", lines[1])
+	}
+}
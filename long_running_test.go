@@ -0,0 +1,65 @@
+package devtui
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	. "github.com/cdvelop/tinystring"
+)
+
+// slowEditHandler blocks in Change for delay
+type slowEditHandler struct {
+	delay time.Duration
+	value string
+}
+
+func (h *slowEditHandler) Name() string  { return "Slow" }
+func (h *slowEditHandler) Label() string { return "Slow" }
+func (h *slowEditHandler) Value() string { return h.value }
+func (h *slowEditHandler) Change(newValue string, progress chan<- string) {
+	time.Sleep(h.delay)
+	h.value = newValue
+}
+
+func runLongRunningTest(t *testing.T, delay, timeout time.Duration) bool {
+	t.Helper()
+	h := NewTUI(&TuiConfig{
+		AppName:            "LongRunning",
+		ExitChan:           make(chan bool),
+		LongRunningWarning: 20 * time.Millisecond,
+		Logger:             func(messages ...any) {},
+	})
+	tab := h.NewTabSection("Slow", "Long running test")
+	h.AddHandler(&slowEditHandler{delay: delay}, timeout, "", tab)
+	ts := tab.(*tabSection)
+
+	ts.fieldHandlers[0].executeAsyncChange("new")
+
+	ts.mu.RLock()
+	defer ts.mu.RUnlock()
+	for _, c := range ts.tabContents {
+		if strings.Contains(c.Content, longRunningMessage) {
+			if c.Type != Msg.Warning {
+				t.Errorf("Expected long running notice to be a warning, got type %v", c.Type)
+			}
+			return true
+		}
+	}
+	return false
+}
+
+func TestLongRunningWarningWithoutTimeout(t *testing.T) {
+	if !runLongRunningTest(t, 100*time.Millisecond, 0) {
+		t.Error("Expected long running warning for a slow handler without timeout")
+	}
+}
+
+func TestLongRunningWarningSkipped(t *testing.T) {
+	if runLongRunningTest(t, 0, 0) {
+		t.Error("Expected no warning when the operation finishes before the threshold")
+	}
+	if runLongRunningTest(t, 100*time.Millisecond, time.Second) {
+		t.Error("Expected no warning when the handler has a timeout")
+	}
+}
@@ -0,0 +1,71 @@
+package devtui
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// pinHandler is an edit handler limited to 4 characters via MaxLength()
+type pinHandler struct {
+	*TestEditableHandler
+	maxLen int
+}
+
+func (h *pinHandler) MaxLength() int { return h.maxLen }
+
+func setupMaxLengthTest(t *testing.T, maxLen int) (*DevTUI, *field) {
+	h := DefaultTUIForTest()
+	tab := h.NewTabSection("Test Tab", "Test description")
+	h.AddHandler(&pinHandler{TestEditableHandler: NewTestEditableHandler("PIN", ""), maxLen: maxLen}, 0, "", tab)
+
+	h.viewport.Width = 80
+	h.viewport.Height = 24
+
+	testTabIndex := GetFirstTestTabIndex()
+	h.activeTab = testTabIndex
+	h.editModeActivated = true
+	h.TabSections[testTabIndex].indexActiveEditField = 0
+
+	field := h.TabSections[testTabIndex].fieldHandlers[0]
+	field.tempEditValue = ""
+	field.cursor = 0
+	return h, field
+}
+
+func TestMaxLengthRejectsExtraRunes(t *testing.T) {
+	h, field := setupMaxLengthTest(t, 4)
+
+	for _, r := range "123456" {
+		h.handleKeyboard(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{r}})
+	}
+
+	if field.tempEditValue != "1234" {
+		t.Errorf("Expected tempEditValue '1234', got '%s'", field.tempEditValue)
+	}
+	if field.cursor != 4 {
+		t.Errorf("Expected cursor 4, got %d", field.cursor)
+	}
+}
+
+func TestMaxLengthAppliesToSpaceKey(t *testing.T) {
+	h, field := setupMaxLengthTest(t, 3)
+
+	h.handleKeyboard(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("ab")})
+	h.handleKeyboard(tea.KeyMsg{Type: tea.KeySpace})
+	h.handleKeyboard(tea.KeyMsg{Type: tea.KeySpace})
+
+	if field.tempEditValue != "ab " {
+		t.Errorf("Expected tempEditValue 'ab ', got '%s'", field.tempEditValue)
+	}
+}
+
+func TestMaxLengthZeroMeansNoLimit(t *testing.T) {
+	h, field := setupMaxLengthTest(t, 0)
+
+	h.handleKeyboard(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("123456789")})
+
+	if field.tempEditValue != "123456789" {
+		t.Errorf("Expected tempEditValue '123456789', got '%s'", field.tempEditValue)
+	}
+}
@@ -0,0 +1,51 @@
+package devtui
+
+import (
+	"strings"
+	"testing"
+
+	. "github.com/cdvelop/tinystring"
+)
+
+func TestMaxTabsLimitEnforcedAndLogged(t *testing.T) {
+	var logs []string
+	h := NewTUI(&TuiConfig{
+		ExitChan: make(chan bool),
+		MaxTabs:  3, // SHORTCUTS + 2 user tabs
+		Logger: func(messages ...any) {
+			logs = append(logs, Fmt("%v", messages...))
+		},
+	})
+	h.SetTestMode(true)
+
+	h.NewTabSection("One", "")
+	h.NewTabSection("Two", "")
+	extra := h.NewTabSection("Three", "")
+
+	if len(h.TabSections) != 3 {
+		t.Errorf("Expected 3 tabs with MaxTabs=3, got %d", len(h.TabSections))
+	}
+	if len(logs) != 1 || !strings.Contains(logs[0], "MaxTabs") || !strings.Contains(logs[0], "Three") {
+		t.Errorf("Expected one MaxTabs error mentioning the tab, got %q", logs)
+	}
+
+	// The detached tab stays usable without affecting the UI
+	h.AddHandler(NewTestEditableHandler("Ignored", "x"), 0, "", extra)
+	log := h.AddLogger("IgnoredLog", false, "", extra)
+	log("not shown")
+	for _, tab := range h.TabSections {
+		if tab == extra.(*tabSection) {
+			t.Error("Detached tab must not be added to TabSections")
+		}
+	}
+}
+
+func TestMaxTabsZeroMeansNoLimit(t *testing.T) {
+	h := DefaultTUIForTest()
+	for i := 0; i < 10; i++ {
+		h.NewTabSection(Fmt("Tab%d", i), "")
+	}
+	if len(h.TabSections) != 11 {
+		t.Errorf("Expected 11 tabs without MaxTabs, got %d", len(h.TabSections))
+	}
+}
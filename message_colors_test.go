@@ -0,0 +1,59 @@
+package devtui
+
+import (
+	"testing"
+
+	. "github.com/cdvelop/tinystring"
+	"github.com/charmbracelet/lipgloss"
+)
+
+func TestMessageColorsOverridePalette(t *testing.T) {
+	style := newTuiStyle(nil, map[MessageType]string{
+		Msg.Error:   "#FF5555",
+		Msg.Success: "#50FA7B",
+	})
+	palette := DefaultPalette()
+
+	cases := []struct {
+		name  string
+		style lipgloss.Style
+		want  string
+	}{
+		{"error override", style.errStyle, "#FF5555"},
+		{"success override", style.successStyle, "#50FA7B"},
+		{"warning fallback", style.warnStyle, palette.Warning},
+		{"info fallback", style.infoStyle, palette.Info},
+	}
+	for _, c := range cases {
+		if got := c.style.GetForeground(); got != lipgloss.Color(c.want) {
+			t.Errorf("%s: expected foreground %s, got %v", c.name, c.want, got)
+		}
+	}
+	if style.normalStyle != nil {
+		t.Error("Expected normal messages to stay uncolored without a Msg.Normal override")
+	}
+}
+
+func TestMessageColorsNormalOverride(t *testing.T) {
+	style := newTuiStyle(nil, map[MessageType]string{Msg.Normal: "#CCCCCC"})
+	if style.normalStyle == nil {
+		t.Fatal("Expected normal style when Msg.Normal has an override")
+	}
+	if got := style.normalStyle.GetForeground(); got != lipgloss.Color("#CCCCCC") {
+		t.Errorf("Expected normal foreground #CCCCCC, got %v", got)
+	}
+}
+
+func TestMessageColorsFromConfig(t *testing.T) {
+	h := NewTUI(&TuiConfig{
+		AppName:       "Colors",
+		ExitChan:      make(chan bool),
+		MessageColors: map[MessageType]string{Msg.Warning: "#F1FA8C"},
+	})
+	if got := h.warnStyle.GetForeground(); got != lipgloss.Color("#F1FA8C") {
+		t.Errorf("Expected warning foreground #F1FA8C, got %v", got)
+	}
+	if got := h.errStyle.GetForeground(); got != lipgloss.Color(DefaultPalette().Error) {
+		t.Errorf("Expected error foreground to fall back to palette, got %v", got)
+	}
+}
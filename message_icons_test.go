@@ -0,0 +1,82 @@
+package devtui
+
+import (
+	"strings"
+	"testing"
+
+	. "github.com/cdvelop/tinystring"
+	"github.com/charmbracelet/x/ansi"
+)
+
+func TestMessageIconsPrefixContent(t *testing.T) {
+	h := NewTUI(&TuiConfig{
+		AppName:        "Icons",
+		ExitChan:       make(chan bool),
+		Logger:         func(...any) {},
+		HideTimestamps: true,
+		MessageIcons:   map[MessageType]string{Msg.Error: "E!", Msg.Success: "✓"},
+	})
+	h.SetTestMode(true)
+	h.viewport.Width = 80
+	tab := h.NewTabSection("Build", "Icons test")
+	h.AddLogger("Builder", false, "", tab)
+	ts := tab.(*tabSection)
+
+	h.sendMessageWithHandler("build failed", Msg.Error, ts, "Builder", "", "")
+	h.sendMessageWithHandler("compiling", Msg.Normal, ts, "Builder", "", "")
+
+	errLine := ansi.Strip(h.formatMessage(ts.tabContents[0]))
+	if !strings.Contains(errLine, "E! build failed") {
+		t.Errorf("Expected error glyph before the content, got %q", errLine)
+	}
+	normalLine := ansi.Strip(h.formatMessage(ts.tabContents[1]))
+	if strings.Contains(normalLine, "E!") || strings.Contains(normalLine, "✓") {
+		t.Errorf("Expected no glyph for types without icon, got %q", normalLine)
+	}
+}
+
+func TestMessageIconsDisabledByDefault(t *testing.T) {
+	h := DefaultTUIForTest()
+	h.viewport.Width = 80
+	tab := h.NewTabSection("Build", "Icons test")
+	h.AddLogger("Builder", false, "", tab)
+	ts := tab.(*tabSection)
+
+	h.sendMessageWithHandler("build failed", Msg.Error, ts, "Builder", "", "")
+	for _, icon := range DefaultMessageIcons() {
+		if strings.Contains(ansi.Strip(h.formatMessage(ts.tabContents[0])), icon) {
+			t.Errorf("Expected no icon without TuiConfig.MessageIcons, found %q", icon)
+		}
+	}
+}
+
+func TestMessageIconsKeepContentAligned(t *testing.T) {
+	h := NewTUI(&TuiConfig{
+		AppName:        "Icons",
+		ExitChan:       make(chan bool),
+		Logger:         func(...any) {},
+		HideTimestamps: true,
+		NoColor:        true,
+		MessageIcons:   map[MessageType]string{Msg.Error: "E!", Msg.Success: "✓"},
+	})
+	h.SetTestMode(true)
+	h.viewport.Width = 80
+	tab := h.NewTabSection("Build", "Icons test")
+	h.AddLogger("Builder", false, "", tab)
+	ts := tab.(*tabSection)
+
+	h.sendMessageWithHandler("failed", Msg.Error, ts, "Builder", "", "")
+	h.sendMessageWithHandler("passed", Msg.Success, ts, "Builder", "", "")
+	h.sendMessageWithHandler("plain", Msg.Normal, ts, "Builder", "", "")
+
+	column := -1
+	for i, content := range []string{"failed", "passed", "plain"} {
+		line := ansi.Strip(h.formatMessage(ts.tabContents[i]))
+		col := ansi.StringWidth(line[:strings.Index(line, content)])
+		if column == -1 {
+			column = col
+		} else if col != column {
+			t.Errorf("Expected %q to start at column %d like the others, got %d in %q", content, column, col, line)
+		}
+	}
+}
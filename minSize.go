@@ -0,0 +1,26 @@
+package devtui
+
+import (
+	. "github.com/cdvelop/tinystring"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// Default TuiConfig.MinWidth / MinHeight: header, one content line and footer
+const (
+	defaultMinWidth  = 30
+	defaultMinHeight = 6
+)
+
+// tooSmall reports whether the last WindowSizeMsg is below TuiConfig.MinWidth/MinHeight
+func (h *DevTUI) tooSmall() bool {
+	if h.windowWidth == 0 && h.windowHeight == 0 {
+		return false // size unknown yet
+	}
+	return h.windowWidth < h.MinWidth || h.windowHeight < h.MinHeight
+}
+
+// tooSmallView replaces the layout with a centered notice until the window grows
+func (h *DevTUI) tooSmallView() string {
+	text := h.truncate(Fmt("Terminal too small (needs %dx%d)", h.MinWidth, h.MinHeight), h.windowWidth)
+	return lipgloss.Place(h.windowWidth, h.windowHeight, lipgloss.Center, lipgloss.Center, text)
+}
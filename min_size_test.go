@@ -0,0 +1,52 @@
+package devtui
+
+import (
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/x/ansi"
+)
+
+func TestTooSmallTerminalShowsNotice(t *testing.T) {
+	h := DefaultTUIForTest()
+	tab := h.NewTabSection("Logs", "Min size test")
+	h.AddHandler(NewTestEditableHandler("Port", "8080"), 0, "", tab)
+	h.activeTab = tab.(*tabSection).index
+
+	h.Update(tea.WindowSizeMsg{Width: 50, Height: 4})
+	view := ansi.Strip(h.View())
+	if !strings.Contains(view, "Terminal too small (needs 30x6)") {
+		t.Fatalf("Expected too small notice, got %q", view)
+	}
+	if lines := strings.Split(view, "\n"); len(lines) != 4 {
+		t.Errorf("Expected the notice to fill the 4-line window, got %d lines", len(lines))
+	}
+
+	// Resuming the normal layout once the window is big enough
+	h.Update(tea.WindowSizeMsg{Width: 50, Height: 12})
+	view = ansi.Strip(h.View())
+	if strings.Contains(view, "too small") || !strings.Contains(view, "Port") {
+		t.Errorf("Expected the normal layout after resizing, got %q", view)
+	}
+}
+
+func TestTinyTerminalSizesDoNotPanic(t *testing.T) {
+	for _, guard := range []bool{true, false} {
+		h := DefaultTUIForTest()
+		if !guard {
+			h.MinWidth, h.MinHeight = 1, 1
+		}
+		tab := h.NewTabSection("Logs", "Min size test")
+		h.AddHandler(NewTestEditableHandler("Port", "8080"), 0, "", tab)
+		log := h.AddLogger("Builder", false, "", tab)
+		log("compiled")
+		h.activeTab = tab.(*tabSection).index
+
+		for _, size := range [][2]int{{0, 0}, {1, 1}, {5, 2}, {12, 3}, {29, 5}, {2, 40}} {
+			h.Update(tea.WindowSizeMsg{Width: size[0], Height: size[1]})
+			h.updateViewport()
+			_ = h.View()
+		}
+	}
+}
@@ -0,0 +1,41 @@
+package devtui
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestMultilineGuides(t *testing.T) {
+	h := NewTUI(&TuiConfig{ExitChan: make(chan bool), MultilineGuides: true})
+	h.SetTestMode(true)
+	h.viewport.Width = 80
+	h.viewport.Height = 20
+
+	tab := h.NewTabSection("Logs", "Multi-line")
+	log := h.AddLogger("Stack", false, "", tab)
+	log("panic: boom\nmain.go:10\nmain.go:20")
+
+	ts := tab.(*tabSection)
+	lines := strings.Split(h.formatMessage(ts.tabContents[0]), "\n")
+	if len(lines) != 3 {
+		t.Fatalf("Expected 3 lines, got %d: %q", len(lines), lines)
+	}
+	if strings.HasPrefix(lines[0], multilineGuide) {
+		t.Errorf("First line should not have a guide, got %q", lines[0])
+	}
+	for i, line := range lines[1:] {
+		if !strings.HasPrefix(line, multilineGuide) {
+			t.Errorf("Line %d: expected guide prefix %q, got %q", i+2, multilineGuide, line)
+		}
+	}
+}
+
+func TestMultilineGuidesDisabledByDefault(t *testing.T) {
+	h := DefaultTUIForTest()
+	h.viewport.Width = 80
+
+	out := h.wrapMessageContent("", "a\nb\nc", func(s string) string { return s })
+	if strings.Contains(out, multilineGuide) {
+		t.Errorf("Guides should be off by default, got %q", out)
+	}
+}
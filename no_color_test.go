@@ -0,0 +1,90 @@
+package devtui
+
+import (
+	"strings"
+	"testing"
+
+	. "github.com/cdvelop/tinystring"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/muesli/termenv"
+)
+
+// renderNoColorTab sends a few styled messages and returns the rendered tab content
+func renderNoColorTab(t *testing.T, c *TuiConfig) string {
+	t.Helper()
+	c.Logger = func(messages ...any) {}
+	h := NewTUI(c)
+	h.SetTestMode(true)
+	h.viewport.Width = 80
+	h.viewport.Height = 10
+
+	tab := h.NewTabSection("Plain", "No color test")
+	log := h.AddLogger("Build", false, "#FF6600", tab)
+	log("build started")
+	log("error: compile failed")
+	log("success: done")
+	h.activeTab = GetFirstTestTabIndex()
+
+	return h.ContentView()
+}
+
+func TestNoColorRendersPlainText(t *testing.T) {
+	previous := lipgloss.ColorProfile()
+	defer lipgloss.SetColorProfile(previous)
+	lipgloss.SetColorProfile(termenv.TrueColor)
+
+	out := renderNoColorTab(t, &TuiConfig{AppName: "Plain", ExitChan: make(chan bool), NoColor: true})
+
+	if strings.Contains(out, "\x1b[") {
+		t.Errorf("Expected no ANSI escape sequences with NoColor, got %q", out)
+	}
+	for _, want := range []string{"Build", "build started", "compile failed", "done"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("Expected plain output to keep %q, got %q", want, out)
+		}
+	}
+}
+
+func TestNoColorEnvVar(t *testing.T) {
+	previous := lipgloss.ColorProfile()
+	defer lipgloss.SetColorProfile(previous)
+	lipgloss.SetColorProfile(termenv.TrueColor)
+	t.Setenv("NO_COLOR", "1")
+
+	c := &TuiConfig{AppName: "Plain", ExitChan: make(chan bool), ForceColorProfile: "truecolor"}
+	out := renderNoColorTab(t, c)
+
+	if !c.NoColor {
+		t.Error("Expected NO_COLOR to enable TuiConfig.NoColor")
+	}
+	if strings.Contains(out, "\x1b[") {
+		t.Errorf("Expected no ANSI escape sequences with NO_COLOR, got %q", out)
+	}
+}
+
+func TestNoColorStripsPreColoredContent(t *testing.T) {
+	previous := lipgloss.ColorProfile()
+	defer lipgloss.SetColorProfile(previous)
+
+	h := NewTUI(&TuiConfig{AppName: "Plain", ExitChan: make(chan bool), Logger: func(...any) {}, NoColor: true})
+	h.SetTestMode(true)
+	h.viewport.Width = 80
+	// Another component switching the global profile back must not bring colors back
+	lipgloss.SetColorProfile(termenv.TrueColor)
+
+	tab := h.NewTabSection("Plain", "No color test")
+	log := h.AddLogger("Tool", false, "", tab)
+	log("\x1b[31mcompile failed\x1b[0m")
+	log("error: plain failure")
+	h.activeTab = GetFirstTestTabIndex()
+
+	ts := tab.(*tabSection)
+	for _, msg := range ts.tabContents {
+		if out := h.formatMessage(msg); strings.Contains(out, "\x1b[") {
+			t.Errorf("Expected no ANSI escape sequences with NoColor, got %q", out)
+		}
+	}
+	if got := h.applyMessageTypeStyle("boom", Msg.Error); got != "boom" {
+		t.Errorf("Expected applyMessageTypeStyle passthrough with NoColor, got %q", got)
+	}
+}
@@ -0,0 +1,39 @@
+package devtui
+
+import "strconv"
+
+// Numeric edit fields: an edit handler implementing Numeric() (min, max, step int) lets
+// Up/Down in edit mode increment/decrement the value by step within [min, max].
+// Typing still works for direct entry; the value is committed with Enter as usual.
+
+// stepNumeric adds delta steps to the value being edited. Returns false when the
+// handler is not numeric (Up/Down keep scrolling the viewport).
+func (f *field) stepNumeric(delta int) bool {
+	if f.handler == nil || f.handler.numericFunc == nil {
+		return false
+	}
+	low, high, step := f.handler.numericFunc()
+	if step <= 0 {
+		step = 1
+	}
+
+	current := f.tempEditValue
+	if current == "" {
+		current = f.Value()
+	}
+	value, err := strconv.Atoi(current)
+	if err != nil {
+		value = low // not a number yet: start from the lower bound
+	} else {
+		value += delta * step
+	}
+	if high >= low {
+		value = min(max(value, low), high)
+	}
+
+	f.snapshotEdit()
+	f.tempEditValue = strconv.Itoa(value)
+	f.cursor = len([]rune(f.tempEditValue))
+	f.notifyEdit()
+	return true
+}
@@ -0,0 +1,74 @@
+package devtui
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// workersHandler is a numeric edit field between 1 and 16 in steps of 4
+type workersHandler struct {
+	*TestEditableHandler
+}
+
+func (w *workersHandler) Numeric() (min, max, step int) { return 1, 16, 4 }
+
+func TestNumericFieldUpDownSteps(t *testing.T) {
+	h := DefaultTUIForTest()
+	h.viewport.Width = 80
+	h.viewport.Height = 10
+	tab := h.NewTabSection("Config", "Numeric field test")
+	handler := &workersHandler{NewTestEditableHandler("Workers", "8")}
+	h.AddHandler(handler, 0, "", tab)
+	ts := tab.(*tabSection)
+	h.activeTab = ts.index
+	f := ts.fieldHandlers[0]
+
+	h.handleKeyboard(tea.KeyMsg{Type: tea.KeyEnter})
+	h.handleKeyboard(tea.KeyMsg{Type: tea.KeyUp})
+	if f.tempEditValue != "12" {
+		t.Fatalf("Expected Up to add the step, got %q", f.tempEditValue)
+	}
+
+	// Bounded by max and min
+	h.handleKeyboard(tea.KeyMsg{Type: tea.KeyUp})
+	h.handleKeyboard(tea.KeyMsg{Type: tea.KeyUp})
+	if f.tempEditValue != "16" {
+		t.Errorf("Expected the value clamped at max 16, got %q", f.tempEditValue)
+	}
+	for i := 0; i < 6; i++ {
+		h.handleKeyboard(tea.KeyMsg{Type: tea.KeyDown})
+	}
+	if f.tempEditValue != "1" {
+		t.Errorf("Expected the value clamped at min 1, got %q", f.tempEditValue)
+	}
+
+	// Typing still works, and Up continues from the typed number
+	h.handleKeyboard(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'0'}})
+	h.handleKeyboard(tea.KeyMsg{Type: tea.KeyDown})
+	if f.tempEditValue != "6" {
+		t.Errorf("Expected 10 - 4 = 6 after typing, got %q", f.tempEditValue)
+	}
+
+	// Committed with Enter as usual
+	h.handleKeyboard(tea.KeyMsg{Type: tea.KeyEnter})
+	if handler.Value() != "6" {
+		t.Errorf("Expected Enter to commit 6, got %q", handler.Value())
+	}
+}
+
+func TestUpDownScrollForNonNumericFields(t *testing.T) {
+	h := DefaultTUIForTest()
+	h.viewport.Width = 80
+	h.viewport.Height = 10
+	tab := h.NewTabSection("Config", "Numeric field test")
+	h.AddHandler(NewTestEditableHandler("Port", "8080"), 0, "", tab)
+	ts := tab.(*tabSection)
+	h.activeTab = ts.index
+
+	h.handleKeyboard(tea.KeyMsg{Type: tea.KeyEnter})
+	h.handleKeyboard(tea.KeyMsg{Type: tea.KeyUp})
+	if got := ts.fieldHandlers[0].tempEditValue; got != "8080" {
+		t.Errorf("Expected Up to leave non-numeric values alone, got %q", got)
+	}
+}
@@ -0,0 +1,43 @@
+package devtui
+
+import (
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// previewHandler records every value received through OnEdit()
+type previewHandler struct {
+	*TestEditableHandler
+	previews []string
+}
+
+func (h *previewHandler) OnEdit(current string) { h.previews = append(h.previews, current) }
+
+func TestOnEditReceivesEachKeystroke(t *testing.T) {
+	h := DefaultTUIForTest()
+	h.viewport.Width = 80
+	tab := h.NewTabSection("Preview", "Live preview")
+	handler := &previewHandler{TestEditableHandler: NewTestEditableHandler("Template", "")}
+	h.AddHandler(handler, 0, "", tab)
+
+	h.activeTab = GetFirstTestTabIndex()
+	h.handleKeyboard(tea.KeyMsg{Type: tea.KeyEnter})
+
+	h.handleKeyboard(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("h")})
+	h.handleKeyboard(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("i")})
+	h.handleKeyboard(tea.KeyMsg{Type: tea.KeySpace})
+	h.handleKeyboard(tea.KeyMsg{Type: tea.KeyBackspace})
+	h.handleKeyboard(tea.KeyMsg{Type: tea.KeyLeft}) // cursor move does not change the value
+
+	expected := []string{"h", "hi", "hi ", "hi"}
+	if strings.Join(handler.previews, "|") != strings.Join(expected, "|") {
+		t.Errorf("Expected OnEdit values %q, got %q", expected, handler.previews)
+	}
+
+	// OnEdit is a preview only: the value is committed by Enter
+	if handler.Value() != "" {
+		t.Errorf("OnEdit must not commit the value, got '%s'", handler.Value())
+	}
+}
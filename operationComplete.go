@@ -0,0 +1,20 @@
+package devtui
+
+// completedMark precedes the content of completed operation lines
+const completedMark = "✓ "
+
+// Complete returns text as the final message of a tracked operation (HandlerLoggerTracker or
+// AddLogger with tracking), a MessageUpdate with Final set and its type detected from text.
+// The operation line is updated one last time, rendered with a completed mark and frozen:
+// the next message of the same writer starts a new line.
+//
+//	log("building...")                      // creates the line
+//	log("compiling 3/10")                   // updates it
+//	log(devtui.Complete("build finished"))  // final update, line frozen
+//
+// Typed progress (ChangeTyped/ExecuteTyped) can send it too, or set MessageUpdate.Final.
+func Complete(text string) MessageUpdate {
+	update := detectUpdate(text)
+	update.Final = true
+	return update
+}
@@ -0,0 +1,99 @@
+package devtui
+
+import (
+	"strings"
+	"testing"
+
+	. "github.com/cdvelop/tinystring"
+)
+
+func TestCompleteFreezesTrackedLine(t *testing.T) {
+	h := DefaultTUIForTest()
+	h.viewport.Width = 100
+	h.viewport.Height = 20
+	tab := h.NewTabSection("Build", "Complete test")
+	log := h.AddLogger("Builder", true, "", tab)
+	ts := tab.(*tabSection)
+
+	log("building 1/3")
+	log("building 2/3")
+	log(Complete("build finished"))
+
+	builderLines := func() []tabContent {
+		var lines []tabContent
+		for _, c := range ts.tabContents {
+			if c.RawHandlerName == "Builder" {
+				lines = append(lines, c)
+			}
+		}
+		return lines
+	}
+
+	lines := builderLines()
+	last := lines[len(lines)-1]
+	if last.Content != "build finished" || !last.isComplete {
+		t.Fatalf("Expected completed line \"build finished\", got %+v", last)
+	}
+	for _, c := range lines {
+		if strings.Contains(c.Content, "building 2/3") {
+			t.Errorf("Expected progress line to be replaced by the final message, got %q", c.Content)
+		}
+	}
+
+	log("next build")
+	after := builderLines()
+	if len(after) != len(lines)+1 {
+		t.Fatalf("Expected a new line after completion, got %d lines (was %d)", len(after), len(lines))
+	}
+	if after[len(after)-2].Content != "build finished" || after[len(after)-1].Content != "next build" {
+		t.Errorf("Expected completed line to stay frozen, got %q then %q", after[len(after)-2].Content, after[len(after)-1].Content)
+	}
+	if after[len(after)-1].isComplete {
+		t.Error("Expected the new line not to be marked complete")
+	}
+}
+
+func TestCompleteRendersMark(t *testing.T) {
+	h := DefaultTUIForTest()
+	h.viewport.Width = 100
+	h.viewport.Height = 20
+	tab := h.NewTabSection("Build", "Complete test")
+	log := h.AddLogger("Builder", true, "", tab)
+	h.activeTab = GetFirstTestTabIndex()
+
+	log(Complete("deploy finished"))
+
+	content := h.ContentView()
+	if !strings.Contains(content, completedMark+"deploy finished") {
+		t.Errorf("Expected completed mark before the final message, got:\n%s", content)
+	}
+	if strings.Contains(content, "\x1f") {
+		t.Error("Expected no control bytes in the rendered content")
+	}
+}
+
+func TestCompleteIsTypedNotEncoded(t *testing.T) {
+	final := Complete("build failed: 2 errors")
+	if final.Content != "build failed: 2 errors" || !final.Final {
+		t.Errorf("Expected the plain text with Final set, got %+v", final)
+	}
+	if final.Type != Msg.Error {
+		t.Errorf("Expected the type detected from the text, got %v", final.Type)
+	}
+}
+
+func TestCompleteThroughTypedProgress(t *testing.T) {
+	h := DefaultTUIForTest()
+	tab := h.NewTabSection("Build", "Complete test")
+	h.AddHandler(NewTestEditableHandler("Host", "localhost"), 0, "", tab)
+	f := tab.(*tabSection).fieldHandlers[0]
+
+	f.sendUpdate(MessageUpdate{Content: "deploying"})
+	f.sendUpdate(MessageUpdate{Content: "deployed", Type: Msg.Success, Final: true})
+
+	ts := tab.(*tabSection)
+	last := ts.tabContents[len(ts.tabContents)-1]
+	if last.Content != "deployed" || !last.isComplete {
+		t.Errorf("Expected a Final update to complete the line, got %+v", last)
+	}
+}
@@ -0,0 +1,57 @@
+package devtui
+
+// OverflowPolicy selects how sends behave when tabContentsChan is full. Messages are
+// always stored in the tab: only redraw notifications are skipped, and the tab is
+// redrawn on the next tick instead.
+//
+// Unlike a drop-and-summarize queue, the drop policies emit no "N messages dropped"
+// notice, since no message is lost; the tick redraw is the only signal. The default
+// stays OverflowBlock so existing programs keep every redraw in order.
+type OverflowPolicy int
+
+const (
+	OverflowBlock      OverflowPolicy = iota // producer waits for the UI (default)
+	OverflowDropOldest                       // discard the oldest pending update to make room
+	OverflowDropNewest                       // discard the new update
+)
+
+// notifyContent sends tc to the UI applying TuiConfig.OverflowPolicy
+func (d *DevTUI) notifyContent(tc tabContent) {
+	switch d.OverflowPolicy {
+	case OverflowDropOldest:
+		for {
+			select {
+			case d.tabContentsChan <- tc:
+				return
+			default:
+			}
+			select {
+			case old := <-d.tabContentsChan:
+				old.tabSection.missedRedraw.Store(true)
+			default:
+			}
+		}
+	case OverflowDropNewest:
+		select {
+		case d.tabContentsChan <- tc:
+		default:
+			tc.tabSection.missedRedraw.Store(true)
+		}
+	default:
+		d.tabContentsChan <- tc
+	}
+}
+
+// redrawMissedUpdates redraws the active tab when a notification for it was skipped,
+// so its last messages show up even if no further update arrives. Called on each tick.
+func (d *DevTUI) redrawMissedUpdates() {
+	redraw := false
+	for _, tab := range d.TabSections {
+		if tab.missedRedraw.Swap(false) && tab.index == d.activeTab {
+			redraw = true // other tabs render their messages when they are shown
+		}
+	}
+	if redraw {
+		d.updateViewport()
+	}
+}
@@ -0,0 +1,67 @@
+package devtui
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func floodWithoutConsumer(t *testing.T, policy OverflowPolicy) (*DevTUI, *tabSection) {
+	t.Helper()
+	h := NewTUI(&TuiConfig{ExitChan: make(chan bool), OverflowPolicy: policy})
+	h.SetTestMode(true)
+	tab := h.NewTabSection("Logs", "Overflow")
+	log := h.AddLogger("Flood", false, "", tab)
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for i := 0; i < 500; i++ {
+			log("entry", i)
+		}
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(2 * time.Second):
+		t.Fatal("Producer blocked on full channel")
+	}
+	return h, tab.(*tabSection)
+}
+
+func TestOverflowPoliciesDoNotBlockProducers(t *testing.T) {
+	for name, policy := range map[string]OverflowPolicy{
+		"drop oldest": OverflowDropOldest,
+		"drop newest": OverflowDropNewest,
+	} {
+		t.Run(name, func(t *testing.T) {
+			h, ts := floodWithoutConsumer(t, policy)
+
+			if got := len(ts.tabContents); got != 500 {
+				t.Errorf("Messages must be kept in the tab, expected 500 got %d", got)
+			}
+
+			// Skipped redraws are not data loss: no warning is added to the tab
+			h.Update(h.listenToMessages()())
+			for _, c := range ts.tabContents {
+				if strings.Contains(c.Content, "dropped") {
+					t.Errorf("Expected no dropped notice for skipped redraws, got %q", c.Content)
+				}
+			}
+			if !ts.missedRedraw.Load() {
+				t.Error("Expected the skipped updates to mark the tab for a redraw")
+			}
+
+			// The next tick redraws the active tab with the latest message
+			h.activeTab = ts.index
+			h.viewport.Width, h.viewport.Height = 80, 5
+			h.Update(tickMsg(time.Now()))
+			if ts.missedRedraw.Load() {
+				t.Error("Expected the tick to consume the pending redraw")
+			}
+			if !strings.Contains(h.viewport.View(), "entry 499") {
+				t.Errorf("Expected the last message rendered after the tick, got:\n%s", h.viewport.View())
+			}
+		})
+	}
+}
@@ -0,0 +1,56 @@
+package devtui
+
+import (
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/ansi"
+)
+
+// overlayBox returns the box drawn over the content area, or "" when no overlay is open
+func (h *DevTUI) overlayBox() string {
+	if h.showGlobalHelp {
+		return h.globalHelpBox()
+	}
+	if h.fieldHelpOpen() {
+		return h.fieldHelpBox()
+	}
+	return ""
+}
+
+// renderOverlayBox frames an overlay title and body, leaving a margin inside the viewport
+func (h *DevTUI) renderOverlayBox(title, body, hint string) string {
+	width := min(h.viewport.Width-4, 60)
+	if width < 10 {
+		width = max(h.viewport.Width, 1)
+	}
+	titleStyle := h.newStyle().Bold(true).Foreground(lipgloss.Color(h.Primary))
+	hintStyle := h.newStyle().Foreground(lipgloss.Color(h.Muted))
+	box := h.newStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(lipgloss.Color(h.Primary)).
+		Padding(0, 1).
+		Width(max(width-2, 1)) // el borde ocupa las 2 columnas restantes
+	return box.Render(titleStyle.Render(title) + "\n\n" + body + "\n\n" + hintStyle.Render(hint))
+}
+
+// placeOverlay draws box centered over base (the rendered viewport), keeping the content
+// visible around it. Rows of box beyond the height of base are dropped.
+func placeOverlay(base, box string, width int) string {
+	baseLines := strings.Split(base, "\n")
+	boxLines := strings.Split(box, "\n")
+	boxWidth := lipgloss.Width(box)
+	top := max(0, (len(baseLines)-len(boxLines))/2)
+	left := max(0, (width-boxWidth)/2)
+
+	for i, line := range boxLines {
+		row := top + i
+		if row >= len(baseLines) {
+			break
+		}
+		under := baseLines[row]
+		pad := strings.Repeat(" ", max(0, left-ansi.StringWidth(under)))
+		baseLines[row] = ansi.Truncate(under, left, "") + pad + line + ansi.TruncateLeft(under, left+boxWidth, "")
+	}
+	return strings.Join(baseLines, "\n")
+}
@@ -0,0 +1,41 @@
+package devtui
+
+// Paste support: terminals deliver pasted text (Ctrl+V, Ctrl+Shift+V, middle click) as one
+// bracketed-paste KeyRunes burst. Edit fields are single-line, so line breaks and tabs become
+// spaces, and the text is cut to whatever still fits instead of rejecting the whole paste.
+
+// pasteRunes normalizes pasted runes for a single-line field and keeps at most room of them.
+// "\r\n" collapses to one space; other control characters are dropped.
+func pasteRunes(pasted []rune, room int) []rune {
+	out := make([]rune, 0, len(pasted))
+	for i, r := range pasted {
+		switch {
+		case r == '\n' && i > 0 && pasted[i-1] == '\r':
+			continue // second half of "\r\n"
+		case r == '\r', r == '\n', r == '\t':
+			r = ' '
+		case r < ' ' || r == 0x7f:
+			continue
+		}
+		out = append(out, r)
+	}
+	if room < 0 {
+		room = 0
+	}
+	if len(out) > room {
+		out = out[:room]
+	}
+	return out
+}
+
+// pasteRoom returns how many runes can still be inserted into a value of length runes,
+// honouring both the footer width and the handler's MaxLength().
+func (f *field) pasteRoom(length, availableTextWidth int) int {
+	room := availableTextWidth - 1 - length // same bound as typing: total < availableTextWidth
+	if f.handler != nil {
+		if maxLen := f.handler.MaxLength(); maxLen > 0 {
+			room = min(room, maxLen-length)
+		}
+	}
+	return room
+}
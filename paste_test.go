@@ -0,0 +1,70 @@
+package devtui
+
+import (
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func pasteMsg(text string) tea.KeyMsg {
+	return tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(text), Paste: true}
+}
+
+func TestPasteInsertsAtCursor(t *testing.T) {
+	h, field := setupTestWithEditableField(t)
+
+	h.handleKeyboard(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("http://")})
+	h.handleKeyboard(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("/db")})
+	field.cursor = len("http://")
+
+	h.handleKeyboard(pasteMsg("localhost:5432"))
+	if field.tempEditValue != "http://localhost:5432/db" {
+		t.Errorf("Expected paste inserted at cursor, got '%s'", field.tempEditValue)
+	}
+	if field.cursor != len("http://localhost:5432") {
+		t.Errorf("Expected cursor after the pasted text, got %d", field.cursor)
+	}
+
+	// One undo removes the whole paste
+	h.handleKeyboard(tea.KeyMsg{Type: tea.KeyCtrlZ})
+	if field.tempEditValue != "http:///db" {
+		t.Errorf("Expected undo to remove the paste, got '%s'", field.tempEditValue)
+	}
+}
+
+func TestPasteMultiLineBecomesSingleLine(t *testing.T) {
+	h, field := setupTestWithEditableField(t)
+
+	h.handleKeyboard(pasteMsg("one\r\ntwo\nthree\tfour\x07"))
+	if field.tempEditValue != "one two three four" {
+		t.Errorf("Expected line breaks as spaces and control chars dropped, got %q", field.tempEditValue)
+	}
+}
+
+func TestPasteTruncatedToLimits(t *testing.T) {
+	h, field := setupMaxLengthTest(t, 4)
+
+	h.handleKeyboard(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("1")})
+	h.handleKeyboard(pasteMsg("23456789"))
+	if field.tempEditValue != "1234" || field.cursor != 4 {
+		t.Errorf("Expected paste cut to MaxLength: '1234' cursor 4, got '%s' cursor %d", field.tempEditValue, field.cursor)
+	}
+
+	h, field = setupTestWithEditableField(t)
+	_, available := h.calculateInputWidths(field.handler.Label())
+	h.handleKeyboard(pasteMsg(strings.Repeat("x", available*2)))
+	if got := len([]rune(field.tempEditValue)); got != available-1 {
+		t.Errorf("Expected paste cut to the footer width (%d runes), got %d", available-1, got)
+	}
+}
+
+func TestPasteDoesNotTriggerShortcuts(t *testing.T) {
+	h, handler := setupSequenceShortcutTest(t)
+
+	// "x" runs a shortcut when typed, but not when pasted
+	h.handleKeyboard(pasteMsg("x"))
+	if len(handler.values) != 0 || h.pendingKeys != "" {
+		t.Errorf("Expected a pasted rune not to act as a shortcut, got %v", handler.values)
+	}
+}
@@ -0,0 +1,45 @@
+package devtui
+
+import . "github.com/cdvelop/tinystring"
+
+// Pause: Space on a writer-only tab (no fields) freezes the rendered output so fast logs
+// can be read. Messages keep accumulating in tabContents; only the view shows the snapshot
+// taken when pausing, until the next Space resumes and renders everything received.
+
+// togglePause freezes or resumes the rendered output of the tab
+func (ts *tabSection) togglePause() {
+	ts.mu.Lock()
+	defer ts.mu.Unlock()
+	if ts.paused {
+		ts.paused = false
+		ts.frozen = nil
+		return
+	}
+	ts.paused = true
+	ts.frozen = append([]tabContent(nil), ts.tabContents...)
+	ts.pausedAt = ts.messageCount
+}
+
+// pauseState reports whether the tab output is paused and how many messages arrived since
+func (ts *tabSection) pauseState() (paused bool, pending int) {
+	ts.mu.RLock()
+	defer ts.mu.RUnlock()
+	if !ts.paused {
+		return false, 0
+	}
+	return true, ts.messageCount - ts.pausedAt
+}
+
+// renderedContents returns the messages to render: the snapshot while paused.
+// Must be called with ts.mu held.
+func (ts *tabSection) renderedContents() []tabContent {
+	if ts.paused {
+		return ts.frozen
+	}
+	return ts.tabContents
+}
+
+// pauseLabel is shown in the footer while the tab output is paused
+func pauseLabel(pending int) string {
+	return Fmt("PAUSED (%d pending)", pending)
+}
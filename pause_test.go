@@ -0,0 +1,96 @@
+package devtui
+
+import (
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/x/ansi"
+)
+
+func setupPauseTest(t *testing.T) (*DevTUI, *tabSection, func(...any)) {
+	t.Helper()
+	h := DefaultTUIForTest()
+	h.viewport.Width = 80
+	h.viewport.Height = 10
+	tab := h.NewTabSection("Logs", "Pause test")
+	log := h.AddLogger("Server", false, "", tab)
+	ts := tab.(*tabSection)
+	h.activeTab = ts.index
+	return h, ts, log
+}
+
+func TestSpacePausesWriterOutput(t *testing.T) {
+	h, ts, log := setupPauseTest(t)
+	log("request 1")
+	h.updateViewport()
+
+	h.handleKeyboard(tea.KeyMsg{Type: tea.KeySpace})
+	log("request 2")
+	log("request 3")
+	h.updateViewport()
+
+	if paused, pending := ts.pauseState(); !paused || pending != 2 {
+		t.Fatalf("Expected paused with 2 pending, got paused=%v pending=%d", paused, pending)
+	}
+	if view := h.ContentView(); strings.Contains(view, "request 2") || !strings.Contains(view, "request 1") {
+		t.Errorf("Expected the view frozen on the messages before pausing, got:\n%s", view)
+	}
+	if info := ansi.Strip(h.renderFooterInfo()); !strings.Contains(info, "PAUSED (2 pending)") {
+		t.Errorf("Expected the paused indicator in the footer, got %q", info)
+	}
+	ts.mu.RLock()
+	stored := len(ts.tabContents)
+	ts.mu.RUnlock()
+	if stored != 3 {
+		t.Errorf("Expected messages to keep accumulating while paused, got %d", stored)
+	}
+
+	h.handleKeyboard(tea.KeyMsg{Type: tea.KeySpace})
+	if paused, _ := ts.pauseState(); paused {
+		t.Fatal("Expected Space again to resume")
+	}
+	if view := h.ContentView(); !strings.Contains(view, "request 3") {
+		t.Errorf("Expected the pending messages rendered on resume, got:\n%s", view)
+	}
+	if info := ansi.Strip(h.renderFooterInfo()); strings.Contains(info, "PAUSED") {
+		t.Errorf("Expected no paused indicator after resuming, got %q", info)
+	}
+}
+
+func TestSpaceDoesNotPauseTabsWithFields(t *testing.T) {
+	h := DefaultTUIForTest()
+	h.viewport.Width = 80
+	tab := h.NewTabSection("Config", "Pause test")
+	h.AddHandler(NewTestEditableHandler("Host", "localhost"), 0, "", tab)
+	ts := tab.(*tabSection)
+	h.activeTab = ts.index
+
+	h.handleKeyboard(tea.KeyMsg{Type: tea.KeySpace})
+	if paused, _ := ts.pauseState(); paused {
+		t.Error("Expected Space not to pause a tab with fields")
+	}
+}
+
+func TestClearWhilePausedClearsSnapshot(t *testing.T) {
+	h, ts, log := setupPauseTest(t)
+	log("request 1")
+	h.updateViewport()
+
+	h.handleKeyboard(tea.KeyMsg{Type: tea.KeySpace})
+	log("request 2")
+	h.handleKeyboard(tea.KeyMsg{Type: tea.KeyCtrlL})
+
+	if view := h.ContentView(); strings.Contains(view, "request 1") {
+		t.Errorf("Expected the frozen snapshot cleared with the tab, got:\n%s", view)
+	}
+	if paused, pending := ts.pauseState(); !paused || pending != 0 {
+		t.Errorf("Expected still paused with 0 pending after clear, got paused=%v pending=%d", paused, pending)
+	}
+
+	log("request 3")
+	h.handleKeyboard(tea.KeyMsg{Type: tea.KeySpace})
+	if view := h.ContentView(); !strings.Contains(view, "request 3") || strings.Contains(view, "request 2") {
+		t.Errorf("Expected only messages after the clear on resume, got:\n%s", view)
+	}
+}
@@ -0,0 +1,70 @@
+package devtui
+
+import (
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// placeholderHandler is an edit handler that provides Placeholder()
+type placeholderHandler struct {
+	*TestEditableHandler
+}
+
+func (h *placeholderHandler) Placeholder() string { return "e.g. 8080" }
+
+func setupPlaceholderTest(t *testing.T) (*DevTUI, *placeholderHandler, *field) {
+	h := DefaultTUIForTest()
+	tab := h.NewTabSection("Test Tab", "Test description")
+	handler := &placeholderHandler{TestEditableHandler: NewTestEditableHandler("Port", "")}
+	h.AddHandler(handler, 0, "", tab)
+	h.viewport.Width = 80
+	h.activeTab = GetFirstTestTabIndex()
+	return h, handler, h.TabSections[h.activeTab].fieldHandlers[0]
+}
+
+func TestPlaceholderShownWhenEmpty(t *testing.T) {
+	h, _, _ := setupPlaceholderTest(t)
+
+	if footer := h.footerView(); !strings.Contains(footer, "e.g. 8080") {
+		t.Errorf("Expected placeholder in footer, got:\n%s", footer)
+	}
+
+	// Placeholder is also shown once edit mode starts with an empty value
+	h.handleKeyboard(tea.KeyMsg{Type: tea.KeyEnter})
+	if footer := h.footerView(); !strings.Contains(footer, "e.g. 8080") {
+		t.Errorf("Expected placeholder in edit mode footer, got:\n%s", footer)
+	}
+}
+
+func TestPlaceholderDisappearsWhenTyping(t *testing.T) {
+	h, handler, field := setupPlaceholderTest(t)
+
+	h.handleKeyboard(tea.KeyMsg{Type: tea.KeyEnter})
+	h.handleKeyboard(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("9")})
+
+	if footer := h.footerView(); strings.Contains(footer, "e.g. 8080") {
+		t.Errorf("Placeholder should disappear after typing, got:\n%s", footer)
+	}
+	if field.tempEditValue != "9" {
+		t.Errorf("Expected tempEditValue '9', got '%s'", field.tempEditValue)
+	}
+
+	h.handleKeyboard(tea.KeyMsg{Type: tea.KeyEnter})
+	if handler.Value() != "9" {
+		t.Errorf("Expected committed value '9', got '%s'", handler.Value())
+	}
+}
+
+func TestPlaceholderNotCommitted(t *testing.T) {
+	h, handler, _ := setupPlaceholderTest(t)
+
+	// Enter and leave edit mode without typing: placeholder must not become the value
+	h.handleKeyboard(tea.KeyMsg{Type: tea.KeyEnter})
+	h.handleKeyboard(tea.KeyMsg{Type: tea.KeyEnter})
+
+	if handler.Value() != "" {
+		t.Errorf("Placeholder must not be committed, got value '%s'", handler.Value())
+	}
+}
@@ -0,0 +1,63 @@
+package devtui
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// draftHandler keeps uncommitted input when the user leaves edit mode with Esc
+type draftHandler struct {
+	*TestEditableHandler
+}
+
+func (d *draftHandler) PreserveOnEsc() bool { return true }
+
+func TestPreserveOnEscKeepsDraft(t *testing.T) {
+	h := DefaultTUIForTest()
+	h.viewport.Width = 80
+	h.viewport.Height = 24
+	tab := h.NewTabSection("Edit", "Preserve on Esc test")
+	h.AddHandler(&draftHandler{NewTestEditableHandler("Query", "")}, 0, "", tab)
+	ts := tab.(*tabSection)
+	h.activeTab = ts.index
+	f := ts.fieldHandlers[0]
+
+	h.handleKeyboard(tea.KeyMsg{Type: tea.KeyEnter})
+	h.handleKeyboard(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("select")})
+	h.handleKeyboard(tea.KeyMsg{Type: tea.KeyEsc})
+
+	if h.editModeActivated {
+		t.Fatal("Expected Esc to leave edit mode")
+	}
+	if f.tempEditValue != "select" {
+		t.Fatalf("Expected the draft to survive Esc, got %q", f.tempEditValue)
+	}
+	if f.Value() != "" {
+		t.Errorf("Expected the draft not to be committed, got value %q", f.Value())
+	}
+
+	// Re-entering edit mode resumes the draft with the cursor at its end
+	h.handleKeyboard(tea.KeyMsg{Type: tea.KeyEnter})
+	if f.tempEditValue != "select" || f.cursor != len("select") {
+		t.Errorf("Expected to resume %q at cursor 6, got %q at %d", "select", f.tempEditValue, f.cursor)
+	}
+}
+
+func TestEscDiscardsDraftByDefault(t *testing.T) {
+	h := DefaultTUIForTest()
+	h.viewport.Width = 80
+	h.viewport.Height = 24
+	tab := h.NewTabSection("Edit", "Preserve on Esc test")
+	h.AddHandler(NewTestEditableHandler("Query", ""), 0, "", tab)
+	ts := tab.(*tabSection)
+	h.activeTab = ts.index
+
+	h.handleKeyboard(tea.KeyMsg{Type: tea.KeyEnter})
+	h.handleKeyboard(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("select")})
+	h.handleKeyboard(tea.KeyMsg{Type: tea.KeyEsc})
+
+	if got := ts.fieldHandlers[0].tempEditValue; got != "" {
+		t.Errorf("Expected Esc to discard the draft, got %q", got)
+	}
+}
@@ -1,17 +1,32 @@
 package devtui
 
 import (
+	"strconv"
+	"strings"
+	"time"
+
 	. "github.com/cdvelop/tinystring"
 	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/ansi"
 )
 
 // NEW: sendMessageWithHandler sends a message with handler identification
 func (d *DevTUI) sendMessageWithHandler(content string, mt MessageType, tabSection *tabSection, handlerName string, operationID string, handlerColor string) {
+	d.sendUpdateWithHandler(MessageUpdate{Content: content, Type: mt}, tabSection, handlerName, operationID, handlerColor)
+}
+
+// sendUpdateWithHandler is sendMessageWithHandler for a MessageUpdate (Final completes the operation line)
+func (d *DevTUI) sendUpdateWithHandler(msg MessageUpdate, tabSection *tabSection, handlerName string, operationID string, handlerColor string) {
+	// Empty or whitespace-only messages would only render as blank lines
+	if !d.KeepBlankMessages && strings.TrimSpace(msg.Content) == "" {
+		return
+	}
+
 	// Use update or add function that handles operationID reuse
-	_, newContent := tabSection.updateOrAddContentWithHandler(mt, content, handlerName, operationID, handlerColor)
+	_, newContent := tabSection.updateOrAddContent(msg, handlerName, operationID, handlerColor)
 
 	// Always send to channel to trigger UI update, regardless of whether content was updated or added new
-	d.tabContentsChan <- newContent
+	d.notifyContent(newContent)
 
 	// Call SetLastOperationID on the handler after processing
 	// First try writing handlers, then field handlers
@@ -29,7 +44,11 @@ func (d *DevTUI) sendMessageWithHandler(content string, mt MessageType, tabSecti
 	}
 
 	if targetHandler != nil {
-		targetHandler.SetLastOperationID(newContent.Id)
+		if newContent.isComplete {
+			targetHandler.SetLastOperationID("") // next message starts a new line
+		} else {
+			targetHandler.SetLastOperationID(newContent.Id)
+		}
 	} else {
 		// Handler not found; log available handlers for diagnosis
 		if tabSection.tui != nil && tabSection.tui.Logger != nil {
@@ -45,33 +64,141 @@ func (d *DevTUI) sendMessageWithHandler(content string, mt MessageType, tabSecti
 
 // formatMessage formatea un mensaje según su tipo
 func (t *DevTUI) formatMessage(msg tabContent) string {
+	// Coalesced messages show how many times they were repeated
+	if msg.repeatCount > 1 {
+		msg.Content = Fmt("%s (x%d)", msg.Content, msg.repeatCount)
+	}
+
 	// Check if message comes from a readonly field handler (HandlerDisplay)
 	if msg.handlerName != "" && t.isReadOnlyHandler(msg.handlerName) {
 		// For readonly fields: no timestamp, cleaner visual content, no special coloring
 		return msg.Content
 	}
 
-	// Apply message type styling to content (unified for all handler types)
-	styledContent := t.applyMessageTypeStyle(msg.Content, msg.Type)
+	// Generate timestamp (unified for all handler types that need it), omitted when toggled off
+	var timeStr string
+	if t.showTimestamps {
+		timeStr = t.generateTimestamp(msg.Timestamp) + " "
+	}
+
+	style := func(segment string) string { return t.applyMessageTypeStyle(segment, msg.Type) }
+	switch {
+	case hasANSI(msg.Content):
+		// Already colored by an external tool (e.g. go build): render as-is,
+		// or stripped to plain text with TuiConfig.NoColor
+		if t.NoColor {
+			msg.Content = ansi.Strip(msg.Content)
+		}
+		style = func(segment string) string { return segment }
+	case msg.tabSection != nil && msg.tabSection.isFieldLogger(msg.RawHandlerName):
+		style = func(segment string) string { return t.applyFieldStyle(segment, msg.Type) }
+	case msg.Type == Msg.Normal && msg.tabSection != nil:
+		if handlerStyle, ok := msg.tabSection.messageStyle(msg.RawHandlerName); ok {
+			style = func(segment string) string { return handlerStyle.Render(segment) }
+		}
+	}
 
-	// Generate timestamp (unified for all handler types that need it)
-	timeStr := t.generateTimestamp(msg.Timestamp)
+	// Completed operations (Complete) get a final mark before the content
+	var mark string
+	if msg.isComplete {
+		mark = t.completedStyle.Render(completedMark)
+	}
+	// Severity glyph (TuiConfig.MessageIcons) so types don't rely on color alone;
+	// types without glyph get blank padding so content columns stay aligned
+	if icon := t.MessageIcons[msg.Type]; icon != "" {
+		mark += t.applyMessageTypeStyle(icon, msg.Type) + strings.Repeat(" ", t.messageIconWidth()-ansi.StringWidth(icon)+1)
+	} else if width := t.messageIconWidth(); width > 0 {
+		mark += strings.Repeat(" ", width+1)
+	}
 
 	// Check if message comes from interactive handler - clean format with timestamp only
 	if msg.handlerName != "" && t.isInteractiveHandler(msg.handlerName) {
 		// Interactive handlers: timestamp + content (no handler name for cleaner UX)
-		return Fmt("%s %s", timeStr, styledContent)
+		return t.wrapMessageContent(timeStr+mark, msg.Content, style)
 	}
 
 	// Default format for other handlers (Edit, Execution, Writers)
-	// Use already padded handlerName for consistent width
-	handlerName := t.formatHandlerName(msg.handlerName, msg.handlerColor)
-	return Fmt("%s %s%s", timeStr, handlerName, styledContent)
+	// Use already padded handlerName for consistent width, omitted when toggled off
+	var handlerName string
+	if !t.hideHandlerNames {
+		handlerName = t.formatHandlerName(msg.handlerName, msg.handlerColor)
+	}
+	return t.wrapMessageContent(timeStr+handlerName+mark, msg.Content, style)
+}
+
+// messageIconWidth returns the cell width of the widest TuiConfig.MessageIcons glyph (0: no icons)
+func (t *DevTUI) messageIconWidth() int {
+	width := 0
+	for _, icon := range t.MessageIcons {
+		width = max(width, ansi.StringWidth(icon))
+	}
+	return width
+}
+
+// toggleTimestamps shows/hides message timestamps and re-renders the viewport
+func (t *DevTUI) toggleTimestamps() {
+	t.showTimestamps = !t.showTimestamps
+	t.updateViewport()
+}
+
+// toggleHandlerNames shows/hides the handler names before messages and re-renders the viewport
+func (t *DevTUI) toggleHandlerNames() {
+	t.hideHandlerNames = !t.hideHandlerNames
+	t.updateViewport()
+}
+
+// multilineGuide prefixes continuation lines of multi-line messages (TuiConfig.MultilineGuides)
+const multilineGuide = "│ "
+
+// wrapMessageContent renders prefix + styled content, laying out lines wider than the
+// viewport per TuiConfig.LineWrap. Wrapped segments are indented to align after the prefix.
+func (t *DevTUI) wrapMessageContent(prefix, content string, style func(string) string) string {
+	prefixWidth := lipgloss.Width(prefix)
+	available := t.viewport.Width - t.textContentStyle.GetHorizontalFrameSize() - prefixWidth
+	indent := strings.Repeat(" ", prefixWidth)
+
+	lines := strings.Split(content, "\n")
+	var guide string
+	if t.MultilineGuides && len(lines) > 1 {
+		guide = t.multilineGuideStyle.Render(multilineGuide)
+	}
+
+	var out []string
+	for i, line := range lines {
+		lineWidth := available
+		if i > 0 {
+			lineWidth -= lipgloss.Width(guide)
+		}
+		for j, segment := range t.layoutLine(line, lineWidth) {
+			styled := style(segment)
+			switch {
+			case i == 0 && j == 0:
+				out = append(out, prefix+styled)
+			case j == 0 && t.LineWrap == LineWrapIndent:
+				out = append(out, guide+indent+styled)
+			case j == 0:
+				out = append(out, guide+styled)
+			case i > 0:
+				out = append(out, guide+indent+styled)
+			default:
+				out = append(out, indent+styled)
+			}
+		}
+	}
+	return strings.Join(out, "\n")
+}
+
+// hasANSI reports whether content already contains ANSI escape sequences
+func hasANSI(content string) bool {
+	return strings.Contains(content, "\x1b[")
 }
 
 // Helper methods to reduce code duplication
 
 func (t *DevTUI) applyMessageTypeStyle(content string, msgType MessageType) string {
+	if t.NoColor { // passthrough, independent of the global lipgloss color profile
+		return content
+	}
 	switch msgType {
 	case Msg.Error:
 		return t.errStyle.Render(content)
@@ -82,16 +209,117 @@ func (t *DevTUI) applyMessageTypeStyle(content string, msgType MessageType) stri
 	case Msg.Success:
 		return t.successStyle.Render(content)
 	default:
+		if t.normalStyle != nil {
+			return t.normalStyle.Render(content)
+		}
 		return content
 	}
 }
 
+// applyFieldStyle renders "key=value" pairs with keys in fieldKeyStyle and values
+// styled by message type. Tokens without '=' are styled as plain values.
+func (t *DevTUI) applyFieldStyle(content string, msgType MessageType) string {
+	tokens := strings.Split(content, " ")
+	for i, token := range tokens {
+		if key, value, ok := strings.Cut(token, "="); ok && key != "" {
+			tokens[i] = t.fieldKeyStyle.Render(key) + "=" + t.applyMessageTypeStyle(value, msgType)
+		} else if token != "" {
+			tokens[i] = t.applyMessageTypeStyle(token, msgType)
+		}
+	}
+	return strings.Join(tokens, " ")
+}
+
+// defaultTimestampFormat is used when TuiConfig.TimestampFormat is empty
+const defaultTimestampFormat = "15:04:05"
+
+// TimestampMode selects how message timestamps are rendered
+type TimestampMode int
+
+const (
+	TimestampAbsolute TimestampMode = iota // clock time using TuiConfig.TimestampFormat (default)
+	TimestampRelative                      // age of the message: "5s", "2m", "3h", "1d"
+)
+
 func (t *DevTUI) generateTimestamp(timestamp string) string {
+	layout := t.TimestampFormat
+	if layout == "" {
+		layout = defaultTimestampFormat
+	}
+
+	// Timestamps are unixid values (unix nano, optionally ".sessionNumber"): format in local
+	// time like the header clock. Passing the raw string to FormatTime rendered empty times.
+	if nano, ok := parseTimestampNano(timestamp); ok {
+		if t.TimestampMode == TimestampRelative {
+			return t.timeStyle.Render(t.relativeTime(time.Unix(0, nano)))
+		}
+		return t.timeStyle.Render(time.Unix(0, nano).Format(layout))
+	}
 	if t.timeProvider != nil && timestamp != "" {
-		// FormatTime accepts any (string, int64, etc.) and returns "HH:MM:SS"
-		return t.timeStyle.Render(t.timeProvider.FormatTime(timestamp))
+		// Already formatted values (e.g. "15:04:05") pass through FormatTime unchanged
+		if formatted := t.timeProvider.FormatTime(timestamp); formatted != "" {
+			return t.timeStyle.Render(formatted)
+		}
 	}
-	return t.timeStyle.Render("--:--:--")
+	return t.timeStyle.Render(timestampPlaceholder(layout))
+}
+
+// messageTimestamp returns the timestamp of a new or updated message: unix nano from the
+// TuiConfig.Now clock when set, otherwise a unixid value (same format)
+func (t *DevTUI) messageTimestamp() string {
+	if t.Now != nil {
+		return Convert(t.Now().UnixNano()).String()
+	}
+	if t.id != nil {
+		return t.id.GetNewID()
+	}
+	// Graceful fallback when unixid initialization failed
+	return Convert(time.Now().UnixNano()).String()
+}
+
+// relativeTime renders the age of a message using the largest whole unit ("5s", "1m", "2h", "3d")
+func (t *DevTUI) relativeTime(at time.Time) string {
+	now := time.Now
+	if t.now != nil {
+		now = t.now
+	}
+	age := max(now().Sub(at), 0)
+
+	var rel string
+	switch {
+	case age < time.Minute:
+		rel = Fmt("%ds", int(age/time.Second))
+	case age < time.Hour:
+		rel = Fmt("%dm", int(age/time.Minute))
+	case age < 24*time.Hour:
+		rel = Fmt("%dh", int(age/time.Hour))
+	default:
+		rel = Fmt("%dd", int(age/(24*time.Hour)))
+	}
+	return Fmt("%3s", rel) // fixed width keeps message prefixes aligned
+}
+
+// timestampPlaceholder returns the fallback for a layout with every digit replaced by '-'
+// ("15:04:05" -> "--:--:--", "15:04:05.000" -> "--:--:--.---")
+func timestampPlaceholder(layout string) string {
+	return strings.Map(func(r rune) rune {
+		if r >= '0' && r <= '9' {
+			return '-'
+		}
+		return r
+	}, layout)
+}
+
+// parseTimestampNano extracts the unix nano value from a unixid timestamp ("1624397134562544800" or "1624397134562544800.42")
+func parseTimestampNano(timestamp string) (int64, bool) {
+	if i := strings.IndexByte(timestamp, '.'); i >= 0 {
+		timestamp = timestamp[:i]
+	}
+	nano, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil || nano <= 0 {
+		return 0, false
+	}
+	return nano, true
 }
 
 func (t *DevTUI) formatHandlerName(handlerName string, handlerColor string) string {
@@ -108,16 +336,22 @@ func (t *DevTUI) formatHandlerName(handlerName string, handlerColor string) stri
 	}
 
 	// Create style with handler-specific color as background
-	style := lipgloss.NewStyle().
+	style := t.newStyle().
 		Bold(true).
 		Background(lipgloss.Color(color)).
 		Foreground(lipgloss.Color(t.Foreground)) // Use foreground for text contrast
 
 	styledName := style.Render(handlerName)
 	// styledName := style.Render(Fmt("[%s]", handlerName))
-	return styledName + " "
+	return styledName + t.HandlerNameSeparator
 }
 
+// defaultNewMessagesFormat is the footer indicator unless TuiConfig.NewMessagesFormat is set
+const defaultNewMessagesFormat = "↓ %d new"
+
+// defaultHandlerNameSeparator separates handler name and content unless TuiConfig.HandlerNameSeparator is set
+const defaultHandlerNameSeparator = " "
+
 // Helper to detect readonly handlers
 func (t *DevTUI) isReadOnlyHandler(handlerName string) bool {
 	// Check if handler has empty label (readonly convention)
@@ -159,6 +393,10 @@ func (h *DevTUI) createTabContent(content string, mt MessageType, tabSection *ta
 	}
 
 	var id string
+	newID := timestamp
+	if h.Now != nil { // pinned clock (TuiConfig.Now): the id stays unique
+		timestamp = h.messageTimestamp()
+	}
 	var opID *string
 
 	// Lógica unificada para ID
@@ -167,7 +405,7 @@ func (h *DevTUI) createTabContent(content string, mt MessageType, tabSection *ta
 		opID = &operationID
 	} else {
 		// Usar el mismo timestamp como ID para operaciones nuevas
-		id = timestamp
+		id = newID
 		opID = nil
 	}
 
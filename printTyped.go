@@ -0,0 +1,32 @@
+package devtui
+
+import . "github.com/cdvelop/tinystring"
+
+// PrintTyped appends a message to this tab with the given type, skipping the detection
+// from the text, e.g. a Warning that contains no warning keyword. Errors are also sent
+// to TuiConfig.Logger, as with loggers. Safe to call from any goroutine.
+//
+// Example:
+//
+//	tab.(interface{ PrintTyped(MessageType, ...any) }).PrintTyped(Msg.Warning, "disk at", 91, "%")
+func (ts *tabSection) PrintTyped(mt MessageType, messages ...any) {
+	if len(messages) == 0 {
+		return
+	}
+	message := Translate(messages...).String()
+	ts.tui.sendMessageWithHandler(message, mt, ts, "", "", "")
+
+	if mt == Msg.Error && ts.tui.Logger != nil {
+		ts.tui.Logger(message)
+	}
+}
+
+// PrintTyped appends a message with the given type to the active tab (see tabSection.PrintTyped).
+// Safe to call from any goroutine.
+func (h *DevTUI) PrintTyped(mt MessageType, messages ...any) {
+	index := h.activeIndex()
+	if index >= len(h.TabSections) {
+		return
+	}
+	h.TabSections[index].PrintTyped(mt, messages...)
+}
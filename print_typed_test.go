@@ -0,0 +1,62 @@
+package devtui
+
+import (
+	"testing"
+
+	. "github.com/cdvelop/tinystring"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestPrintTypedKeepsCallerType(t *testing.T) {
+	h := DefaultTUIForTest()
+	tab := h.NewTabSection("Logs", "Print typed test")
+	ts := tab.(*tabSection)
+	h.activeTab = ts.index
+
+	h.PrintTyped(Msg.Warning, "hello")
+	ts.PrintTyped(Msg.Success, "error count:", 0) // detection would say Error
+
+	if len(ts.tabContents) != 2 {
+		t.Fatalf("Expected 2 messages, got %d", len(ts.tabContents))
+	}
+	if c := ts.tabContents[0]; c.Content != "hello" || c.Type != Msg.Warning {
+		t.Errorf("Expected \"hello\" stored as Warning, got %q type %v", c.Content, c.Type)
+	}
+	if c := ts.tabContents[1]; c.Type != Msg.Success {
+		t.Errorf("Expected the explicit Success type to win over detection, got %v", c.Type)
+	}
+}
+
+func TestPrintTypedWhileSwitchingTabs(t *testing.T) {
+	h := DefaultTUIForTest()
+	h.viewport.Width = 80
+	h.viewport.Height = 10
+	h.NewTabSection("Build", "Print typed test")
+	h.NewTabSection("Logs", "Print typed test")
+
+	// Drain UI notifications as the running program would
+	stop := make(chan struct{})
+	defer close(stop)
+	go func() {
+		for {
+			select {
+			case <-h.tabContentsChan:
+			case <-stop:
+				return
+			}
+		}
+	}()
+
+	// App goroutine prints while the UI goroutine switches tabs
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for i := 0; i < 200; i++ {
+			h.PrintTyped(Msg.Warning, "disk at", i, "%")
+		}
+	}()
+	for i := 0; i < 50; i++ {
+		h.Update(tea.KeyMsg{Type: tea.KeyTab})
+	}
+	<-done
+}
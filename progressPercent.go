@@ -0,0 +1,48 @@
+package devtui
+
+import (
+	"strconv"
+	"strings"
+)
+
+// Progress percentage: handlers report progress as text through the progress channel,
+// so a message ending in a percentage ("Uploading... 40%") updates the compact [40%]
+// indicator the footer shows for the active field while its operation runs.
+
+// progressPercent extracts a trailing 0-100 percentage from a progress message
+func progressPercent(msg string) (string, bool) {
+	fields := strings.Fields(msg)
+	if len(fields) == 0 {
+		return "", false
+	}
+	number, ok := strings.CutSuffix(fields[len(fields)-1], "%")
+	if !ok {
+		return "", false
+	}
+	value, err := strconv.ParseFloat(number, 64)
+	if err != nil || value < 0 || value > 100 {
+		return "", false
+	}
+	return strconv.Itoa(int(value)) + "%", true
+}
+
+// setPercent records the latest percentage of the running operation
+func (s *internalAsyncState) setPercent(percent string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.percent = percent
+}
+
+// progressText returns the footer indicator for the running operation, e.g. "[40%]",
+// or "" when nothing runs or no percentage was reported
+func (f *field) progressText() string {
+	if f == nil || f.asyncState == nil {
+		return ""
+	}
+	f.asyncState.mu.RLock()
+	defer f.asyncState.mu.RUnlock()
+	if !f.asyncState.isRunning || f.asyncState.percent == "" {
+		return ""
+	}
+	return "[" + f.asyncState.percent + "]"
+}
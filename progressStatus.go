@@ -0,0 +1,45 @@
+package devtui
+
+import "sync"
+
+// Status returns text as a short progress status for the footer instead of a log line.
+// Send it from the typed progress variants (ChangeTyped/ExecuteTyped) while plain
+// messages keep going to the log as details:
+//
+//	progress <- devtui.Status("Building...")                  // footer status line
+//	progress <- devtui.MessageUpdate{Content: "compiling main.go"} // log detail
+//
+// The status is cleared when the operation finishes or is abandoned (timeout/cancel).
+func Status(text string) MessageUpdate {
+	return MessageUpdate{Content: text, Status: true}
+}
+
+// progressStatus shows the Status updates of one operation in the tab footer status line
+type progressStatus struct {
+	tab   *tabSection
+	mu    sync.Mutex
+	text  string // status currently shown by this operation
+	ended bool   // operation finished or abandoned: later updates are ignored
+}
+
+// show displays text unless the operation already ended
+func (p *progressStatus) show(text string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.ended || p.tab == nil {
+		return
+	}
+	p.text = text
+	p.tab.SetStatus(text)
+}
+
+// end clears the status shown by this operation, unless another one replaced it
+func (p *progressStatus) end() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.ended = true
+	if p.text != "" {
+		p.tab.clearStatus(p.text)
+		p.text = ""
+	}
+}
@@ -0,0 +1,80 @@
+package devtui
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/charmbracelet/x/ansi"
+)
+
+// uploadHandler reports a percentage, then waits for release before finishing
+type uploadHandler struct {
+	reported chan struct{}
+	release  chan struct{}
+}
+
+func (u *uploadHandler) Name() string  { return "Upload" }
+func (u *uploadHandler) Label() string { return "Upload" }
+func (u *uploadHandler) Value() string { return "assets" }
+func (u *uploadHandler) Change(newValue string, progress chan<- string) {
+	progress <- "Uploading... 40%"
+	close(u.reported)
+	<-u.release
+	progress <- "Uploaded"
+}
+
+func TestFooterShowsRunningOperationPercent(t *testing.T) {
+	h := NewTUI(&TuiConfig{
+		AppName:  "Progress",
+		ExitChan: make(chan bool),
+		Logger:   func(...any) {},
+	})
+	h.viewport.Width = 80
+	tab := h.NewTabSection("Deploy", "Progress test")
+	handler := &uploadHandler{reported: make(chan struct{}), release: make(chan struct{})}
+	h.AddHandler(handler, time.Second, "", tab)
+	ts := tab.(*tabSection)
+	h.activeTab = ts.index
+	f := ts.fieldHandlers[0]
+
+	done := make(chan struct{})
+	go func() {
+		f.executeAsyncChange("dist")
+		close(done)
+	}()
+
+	<-handler.reported
+	deadline := time.Now().Add(time.Second)
+	for f.progressText() == "" && time.Now().Before(deadline) {
+		time.Sleep(time.Millisecond) // the progress collector runs in its own goroutine
+	}
+	if got := f.progressText(); got != "[40%]" {
+		t.Errorf("Expected [40%%] while running, got %q", got)
+	}
+	if info := ansi.Strip(h.renderFooterInfo()); !strings.Contains(info, "[40%]") {
+		t.Errorf("Expected the footer to show [40%%], got %q", info)
+	}
+
+	close(handler.release)
+	<-done
+	if got := f.progressText(); got != "" {
+		t.Errorf("Expected the indicator cleared after completion, got %q", got)
+	}
+}
+
+func TestProgressPercent(t *testing.T) {
+	cases := map[string]string{
+		"Uploading... 40%": "40%",
+		"building 99.5%":   "99%",
+		"100%":             "100%",
+		"disk at 140%":     "",
+		"50% done":         "",
+		"no percent":       "",
+	}
+	for msg, want := range cases {
+		if got, _ := progressPercent(msg); got != want {
+			t.Errorf("progressPercent(%q): expected %q, got %q", msg, want, got)
+		}
+	}
+}
@@ -0,0 +1,108 @@
+package devtui
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+)
+
+// buildHandler reports a footer status plus log details, then waits for release
+type buildHandler struct {
+	release chan struct{}
+}
+
+func (h *buildHandler) Name() string                   { return "Build" }
+func (h *buildHandler) Label() string                  { return "Build" }
+func (h *buildHandler) Execute(progress chan<- string) {}
+func (h *buildHandler) ExecuteTyped(progress chan<- MessageUpdate) {
+	progress <- Status("Building...")
+	progress <- MessageUpdate{Content: "compiling main.go"}
+	<-h.release
+}
+
+func TestProgressStatusRoutedToFooter(t *testing.T) {
+	h := NewTUI(&TuiConfig{
+		AppName:  "Progress",
+		ExitChan: make(chan bool),
+		Logger:   func(messages ...any) {},
+	})
+	h.viewport.Width = 80
+	h.viewport.Height = 10
+
+	handler := &buildHandler{release: make(chan struct{})}
+	tab := h.NewTabSection("Build", "Progress status test")
+	h.AddHandler(handler, 5*time.Second, "", tab)
+	ts := tab.(*tabSection)
+	h.activeTab = ts.index
+
+	finished := make(chan struct{})
+	go func() {
+		ts.fieldHandlers[0].executeAsyncChange("")
+		close(finished)
+	}()
+
+	waitFor(t, func() bool { return ts.status() == "Building..." })
+	waitFor(t, func() bool { return strings.Contains(h.ContentView(), "compiling main.go") })
+
+	if footer := h.footerView(); !strings.Contains(footer, "Building...") {
+		t.Errorf("Expected status in footer, got %q", footer)
+	}
+	if content := h.ContentView(); strings.Contains(content, "Building...") {
+		t.Errorf("Expected status to stay out of the log, got %q", content)
+	}
+
+	close(handler.release)
+	<-finished
+	waitFor(t, func() bool { return ts.status() == "" })
+}
+
+func TestStatusOfTimedOutAttemptIsDropped(t *testing.T) {
+	h := NewTUI(&TuiConfig{
+		AppName:  "Progress",
+		ExitChan: make(chan bool),
+		Logger:   func(messages ...any) {},
+	})
+	h.viewport.Width = 80
+	h.viewport.Height = 10
+
+	handler := &buildHandler{release: make(chan struct{})}
+	tab := h.NewTabSection("Build", "Progress status test")
+	h.AddHandler(handler, 100*time.Millisecond, "", tab)
+	ts := tab.(*tabSection)
+	f := ts.fieldHandlers[0]
+
+	progressChan, done := f.collectProgressMessages(context.Background(), nil)
+	ctx, cancel := context.WithCancel(context.Background())
+	late, lateDone := f.collectProgressMessages(ctx, nil)
+
+	progressChan <- Status("Building...")
+	waitFor(t, func() bool { return ts.status() == "Building..." })
+
+	cancel() // abandoned attempt: late status must not overwrite the footer
+	late <- Status("stale")
+	close(late)
+	<-lateDone
+	if got := ts.status(); got != "Building..." {
+		t.Errorf("Expected the abandoned attempt to leave the footer alone, got %q", got)
+	}
+
+	close(progressChan)
+	<-done
+	if got := ts.status(); got != "" {
+		t.Errorf("Expected the status to be cleared when the operation ends, got %q", got)
+	}
+	close(handler.release)
+}
+
+// waitFor polls cond for up to a second
+func waitFor(t *testing.T, cond func() bool) {
+	t.Helper()
+	deadline := time.Now().Add(time.Second)
+	for !cond() {
+		if time.Now().After(deadline) {
+			t.Fatal("condition not met within 1s")
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+}
@@ -0,0 +1,86 @@
+package devtui
+
+import (
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// counterDisplay is a display handler whose data is updated from a background goroutine.
+// It guards its own state, as documented on tabSection.RefreshDisplay.
+type counterDisplay struct {
+	mu    sync.Mutex
+	count int
+}
+
+func (d *counterDisplay) Name() string { return "Counter" }
+
+func (d *counterDisplay) Content() string {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return "count: " + strconv.Itoa(d.count)
+}
+
+func (d *counterDisplay) increment() {
+	d.mu.Lock()
+	d.count++
+	d.mu.Unlock()
+}
+
+func setupRefreshDisplayTest() (*DevTUI, *tabSection, *counterDisplay) {
+	h := DefaultTUIForTest()
+	h.viewport.Width = 80
+	h.viewport.Height = 10
+
+	display := &counterDisplay{}
+	tab := h.NewTabSection("Stats", "Background refresh test")
+	h.AddHandler(display, 0, "", tab)
+	h.activeTab = GetFirstTestTabIndex()
+	return h, tab.(*tabSection), display
+}
+
+func TestRefreshDisplayFromGoroutine(t *testing.T) {
+	h, ts, display := setupRefreshDisplayTest()
+
+	const updates = 200
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for i := 0; i < updates; i++ {
+			display.increment()
+			ts.RefreshDisplay(display.Name())
+		}
+	}()
+
+	for rendering := true; rendering; {
+		select {
+		case <-done:
+			rendering = false
+		default:
+		}
+		h.Update(refreshDisplayMsg{tab: ts, name: display.Name()})
+		_ = h.ContentView()
+	}
+
+	h.Update(refreshDisplayMsg{tab: ts, name: display.Name()})
+	if got := h.ContentView(); !strings.Contains(got, "count: "+strconv.Itoa(updates)) {
+		t.Errorf("Expected final count %d in content, got %q", updates, got)
+	}
+}
+
+func TestRefreshDisplayOnlyForVisibleHandler(t *testing.T) {
+	h, ts, display := setupRefreshDisplayTest()
+
+	if !h.displayVisible(ts, display.Name()) {
+		t.Error("Expected the selected display handler of the active tab to be visible")
+	}
+	if h.displayVisible(ts, "Other") {
+		t.Error("Expected an unknown handler name not to be visible")
+	}
+
+	h.activeTab = 0
+	if h.displayVisible(ts, display.Name()) {
+		t.Error("Expected a display handler of an inactive tab not to be visible")
+	}
+}
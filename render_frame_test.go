@@ -0,0 +1,45 @@
+package devtui
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/charmbracelet/x/ansi"
+)
+
+func TestRenderFramePlain(t *testing.T) {
+	h := NewTUI(&TuiConfig{
+		AppName:        "Frame",
+		ExitChan:       make(chan bool),
+		Logger:         func(...any) {},
+		HideTimestamps: true,
+	})
+	h.SetTestMode(true)
+	h.viewport.Width = 40
+	h.viewport.Height = 4
+	tab := h.NewTabSection("Build", "Frame test")
+	h.AddHandler(NewTestEditableHandler("Port", "8080"), 0, "", tab)
+	log := h.AddLogger("Builder", false, "", tab)
+	log("compiled main.go")
+	h.activeTab = GetFirstTestTabIndex()
+
+	expected := strings.Join([]string{
+		" Frame/Build        ─────────────  2/ 2 ",
+		" Builder  compiled main.go              ",
+		"                                        ",
+		"                                        ",
+		"                                        ",
+		"  1/ 1   Port                 8080        ■  ",
+	}, "\n")
+
+	frame := h.RenderFrame(true)
+	if frame != expected {
+		t.Errorf("Unexpected frame.\nExpected:\n%s\nGot:\n%s", expected, frame)
+	}
+
+	// Same composition as the live View once the window size is known
+	h.ready = true
+	if view := ansi.Strip(h.View()); view != frame {
+		t.Errorf("Expected RenderFrame to match View.\nView:\n%s\nFrame:\n%s", view, frame)
+	}
+}
@@ -0,0 +1,63 @@
+package devtui
+
+import "testing"
+
+func TestResetTrackingStartsNewLine(t *testing.T) {
+	h := DefaultTUIForTest()
+	tab := h.NewTabSection("Health", "Reset tracking test")
+	log := h.AddLogger("HealthCheck", true, "", tab)
+	ts := tab.(*tabSection)
+
+	log("check 1: ok")
+	log("check 1: still ok")
+	before := len(ts.tabContents)
+	if before != 1 {
+		t.Fatalf("Expected the tracked logger to update its first line, got %d lines", before)
+	}
+
+	if !ts.ResetTracking("HealthCheck") {
+		t.Fatal("Expected ResetTracking to find the tracked logger")
+	}
+	log("check 2: ok")
+
+	if got := len(ts.tabContents); got != before+1 {
+		t.Fatalf("Expected reset to start a new line (%d lines), got %d", before+1, got)
+	}
+	if prev := ts.tabContents[len(ts.tabContents)-2].Content; prev != "check 1: still ok" {
+		t.Errorf("Expected previous tracked line untouched, got %q", prev)
+	}
+
+	// Without a reset the new line is tracked again
+	log("check 2: degraded")
+	if got := len(ts.tabContents); got != before+1 {
+		t.Errorf("Expected update of the new tracked line, got %d lines", got)
+	}
+
+	if ts.ResetTracking("Unknown") {
+		t.Error("Expected ResetTracking to report unknown handlers")
+	}
+}
+
+func TestResetTrackingFieldHandler(t *testing.T) {
+	h := DefaultTUIForTest()
+	tab := h.NewTabSection("Health", "Reset tracking test")
+	handler := NewTestEditableHandler("Probe", "ok")
+	h.AddHandler(handler, 0, "", tab)
+	ts := tab.(*tabSection)
+	f := ts.fieldHandlers[0]
+
+	f.executeChangeSyncWithTracking("ok")
+	f.executeChangeSyncWithTracking("ok")
+	before := len(ts.tabContents)
+
+	// An empty operation ID is treated as a new operation
+	ts.ResetTracking(handler.Name())
+	if id := f.handler.GetLastOperationID(); id != "" {
+		t.Fatalf("Expected empty operation ID after reset, got %q", id)
+	}
+	f.executeChangeSyncWithTracking("ok")
+
+	if got := len(ts.tabContents); got != before+1 {
+		t.Errorf("Expected a new line after reset (%d lines), got %d", before+1, got)
+	}
+}
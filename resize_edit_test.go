@@ -0,0 +1,64 @@
+package devtui
+
+import (
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/ansi"
+)
+
+func TestResizeMidEditKeepsCursorVisible(t *testing.T) {
+	value := "abcdefghijklmnopqrstuvwxyz0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZ"
+	h := DefaultTUIForTest()
+	tab := h.NewTabSection("Config", "Resize edit test")
+	h.AddHandler(NewTestEditableHandler("Path", value), 0, "", tab)
+	ts := tab.(*tabSection)
+	h.Update(tea.WindowSizeMsg{Width: 140, Height: 20})
+
+	if err := h.EnterEditMode(ts.index, 0); err != nil {
+		t.Fatalf("EnterEditMode returned error: %v", err)
+	}
+	f := ts.fieldHandlers[0]
+
+	// Cursor at the end, then in the middle of the value
+	for _, left := range []int{0, 30} {
+		f.cursor = len(value) - left
+		h.Update(tea.WindowSizeMsg{Width: 60, Height: 20})
+
+		if f.cursor < 0 || f.cursor > len(value) {
+			t.Fatalf("Expected cursor within the value, got %d", f.cursor)
+		}
+		footer := h.footerBarView()
+		if lipgloss.Height(footer) != 1 {
+			t.Errorf("Expected a single footer line after the resize, got %q", ansi.Strip(footer))
+		}
+		// The characters right before the cursor are visible next to the cursor mark
+		around := value[f.cursor-3:f.cursor] + editCursorMark
+		if plain := ansi.Strip(footer); !strings.Contains(plain, around) {
+			t.Errorf("Expected %q visible at cursor %d, got %q", around, f.cursor, plain)
+		}
+		h.Update(tea.WindowSizeMsg{Width: 140, Height: 20})
+	}
+}
+
+func TestEditWindowFitsWidth(t *testing.T) {
+	cases := []struct {
+		value  string
+		cursor int
+		width  int
+		want   string
+	}{
+		{"8080", 4, 10, "8080▋"},
+		{"abcdefghij", 10, 5, "ghij▋"},
+		{"abcdefghij", 2, 5, "ab▋cd"},
+		{"abcdefghij", 99, 4, "hij▋"},
+		{"ééééé", 1, 3, "é▋é"},
+	}
+	for _, c := range cases {
+		if got := editWindow(c.value, c.cursor, c.width); got != c.want {
+			t.Errorf("editWindow(%q, %d, %d) = %q, want %q", c.value, c.cursor, c.width, got, c.want)
+		}
+	}
+}
@@ -0,0 +1,78 @@
+package devtui
+
+import (
+	"context"
+	"time"
+
+	. "github.com/cdvelop/tinystring"
+)
+
+// Retry on failure: Edit, Execution, Interactive and Toggle handlers may implement
+//
+//	RetryPolicy() (attempts int, backoff time.Duration)
+//
+// An attempt fails when it times out or its last progress message is an error
+// (e.g. progress <- "error: connection refused"). Failed attempts are retried up to
+// attempts times in total, waiting backoff between them and showing "Retry 2/3…" on
+// the operation line; only the last failure is reported. Each attempt gets the full
+// handler timeout, and cancelling the operation stops pending retries. A timed out
+// attempt is only retried once its Change has returned (waiting up to one more timeout).
+
+// detectRetryPolicy wires the optional RetryPolicy() of handlers that run Change/Execute
+func (a *anyHandler) detectRetryPolicy(h any) {
+	if retrier, ok := h.(interface {
+		RetryPolicy() (attempts int, backoff time.Duration)
+	}); ok {
+		a.retryFunc = retrier.RetryPolicy
+	}
+}
+
+// retryPolicy returns the total attempts (at least 1) and the wait between them
+func (f *field) retryPolicy() (attempts int, backoff time.Duration) {
+	if f.handler == nil || f.handler.retryFunc == nil {
+		return 1, 0
+	}
+	attempts, backoff = f.handler.retryFunc()
+	return max(attempts, 1), max(backoff, 0)
+}
+
+// retryMessage is shown before attempt n of total
+func retryMessage(n, total int) string {
+	return Fmt("Retry %d/%d…", n, total)
+}
+
+// waitAttemptFinished waits for the Change of an abandoned attempt to return before the
+// next attempt starts, at most grace (the handler timeout). Returns false when it is still
+// running (or ctx ended): retrying then would run Change twice at once, so retries stop.
+func waitAttemptFinished(ctx context.Context, finished <-chan struct{}, grace time.Duration) bool {
+	select {
+	case <-finished:
+		return true
+	default:
+	}
+	timer := time.NewTimer(grace)
+	defer timer.Stop()
+	select {
+	case <-finished:
+		return true
+	case <-timer.C:
+		return false
+	case <-ctx.Done():
+		return false
+	}
+}
+
+// sleepContext waits d unless ctx ends first; returns false when ctx ended
+func sleepContext(ctx context.Context, d time.Duration) bool {
+	if d <= 0 {
+		return ctx.Err() == nil
+	}
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
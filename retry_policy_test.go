@@ -0,0 +1,161 @@
+package devtui
+
+import (
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// flakyHandler reports an error for the first failures attempts, then succeeds.
+// Attempts listed in hangOn block for hang (longer than the handler timeout) instead,
+// then send a late progress message. overlapped records Change running twice at once.
+type flakyHandler struct {
+	failures   int
+	hangOn     map[int32]bool
+	hang       time.Duration
+	attempts   atomic.Int32
+	active     atomic.Int32
+	overlapped atomic.Bool
+	value      string
+}
+
+func (h *flakyHandler) Name() string  { return "Deploy" }
+func (h *flakyHandler) Label() string { return "Deploy" }
+func (h *flakyHandler) Value() string { return h.value }
+func (h *flakyHandler) Change(newValue string, progress chan<- string) {
+	if h.active.Add(1) > 1 {
+		h.overlapped.Store(true)
+	}
+	defer h.active.Add(-1)
+	n := h.attempts.Add(1)
+	if h.hangOn[n] {
+		time.Sleep(h.hang)
+		progress <- "late progress from the abandoned attempt"
+		return
+	}
+	if int(n) <= h.failures {
+		progress <- "error: connection refused"
+		return
+	}
+	h.value = newValue
+	progress <- "deployed"
+}
+
+func (h *flakyHandler) RetryPolicy() (attempts int, backoff time.Duration) {
+	return 3, time.Millisecond
+}
+
+func newRetryTestTab(t *testing.T, handler *flakyHandler, timeout time.Duration) *tabSection {
+	t.Helper()
+	h := NewTUI(&TuiConfig{
+		AppName:  "Retry",
+		ExitChan: make(chan bool),
+		Logger:   func(messages ...any) {},
+	})
+	tab := h.NewTabSection("Deploy", "Retry policy test")
+	h.AddHandler(handler, timeout, "", tab)
+	return tab.(*tabSection)
+}
+
+func tabContains(ts *tabSection, text string) bool {
+	ts.mu.RLock()
+	defer ts.mu.RUnlock()
+	for _, c := range ts.tabContents {
+		if strings.Contains(c.Content, text) {
+			return true
+		}
+	}
+	return false
+}
+
+func TestRetryPolicyRetriesUntilSuccess(t *testing.T) {
+	handler := &flakyHandler{failures: 2}
+	ts := newRetryTestTab(t, handler, time.Second)
+
+	ts.fieldHandlers[0].executeAsyncChange("release-2")
+
+	if got := handler.attempts.Load(); got != 3 {
+		t.Fatalf("Expected 3 attempts (2 failures + success), got %d", got)
+	}
+	if handler.value != "release-2" {
+		t.Errorf("Expected the successful attempt to apply the value, got %q", handler.value)
+	}
+	if !tabContains(ts, "release-2") {
+		t.Error("Expected the success result once retries succeed")
+	}
+}
+
+func TestRetryPolicyReportsFinalFailureOnly(t *testing.T) {
+	handler := &flakyHandler{failures: 5}
+	ts := newRetryTestTab(t, handler, time.Second)
+
+	ts.fieldHandlers[0].executeAsyncChange("release-2")
+
+	if got := handler.attempts.Load(); got != 3 {
+		t.Fatalf("Expected attempts capped at 3, got %d", got)
+	}
+	if !tabContains(ts, "connection refused") {
+		t.Error("Expected the last error to stay on the operation line")
+	}
+	if handler.value != "" {
+		t.Errorf("Expected no value applied, got %q", handler.value)
+	}
+}
+
+func TestRetryPolicyRetriesTimeouts(t *testing.T) {
+	handler := &flakyHandler{hangOn: map[int32]bool{1: true}, hang: 80 * time.Millisecond}
+	ts := newRetryTestTab(t, handler, 50*time.Millisecond)
+
+	ts.fieldHandlers[0].executeAsyncChange("release-2")
+
+	if got := handler.attempts.Load(); got != 2 {
+		t.Fatalf("Expected a retry after the timed out attempt, got %d attempts", got)
+	}
+	if handler.overlapped.Load() {
+		t.Error("Expected the retry to wait for the timed out Change to return")
+	}
+	if tabContains(ts, "timed out") {
+		t.Error("Expected no timeout report when a retry succeeds")
+	}
+	if tabContains(ts, "late progress") {
+		t.Error("Expected progress of the abandoned attempt to be dropped")
+	}
+}
+
+func TestRetryPolicyStopsWhenTimedOutChangeKeepsRunning(t *testing.T) {
+	handler := &flakyHandler{hangOn: map[int32]bool{1: true}, hang: 300 * time.Millisecond}
+	ts := newRetryTestTab(t, handler, 50*time.Millisecond)
+
+	ts.fieldHandlers[0].executeAsyncChange("release-2")
+
+	if got := handler.attempts.Load(); got != 1 {
+		t.Errorf("Expected no retry while the timed out Change still runs, got %d attempts", got)
+	}
+	if !tabContains(ts, "timed out") {
+		t.Error("Expected the timeout to be reported")
+	}
+	time.Sleep(300 * time.Millisecond) // let the abandoned Change finish
+	if handler.overlapped.Load() {
+		t.Error("Expected Change never to run twice at once")
+	}
+}
+
+func TestRetryPolicyInTestMode(t *testing.T) {
+	h := DefaultTUIForTest()
+	tab := h.NewTabSection("Deploy", "Retry policy test")
+	handler := &flakyHandler{failures: 2}
+	h.AddHandler(handler, 0, "", tab)
+
+	tab.(*tabSection).fieldHandlers[0].handleEnterWithValue("release-2")
+
+	if got := handler.attempts.Load(); got != 3 {
+		t.Errorf("Expected 3 attempts in synchronous test mode, got %d", got)
+	}
+}
+
+func TestRetryMessage(t *testing.T) {
+	if got := retryMessage(2, 3); got != "Retry 2/3…" {
+		t.Errorf("Expected %q, got %q", "Retry 2/3…", got)
+	}
+}
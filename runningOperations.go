@@ -0,0 +1,31 @@
+package devtui
+
+// IsRunning reports whether the field's async operation (Change/Execute) is in progress,
+// including pending retries. Safe to call from any goroutine.
+func (f *field) IsRunning() bool {
+	if f == nil || f.asyncState == nil {
+		return false
+	}
+	running, _ := f.asyncState.running()
+	return running
+}
+
+// HasRunningOperations reports whether any field of any tab has an async operation in
+// progress, e.g. to wait for pending work before exiting. An operation counts as running
+// until its result has been reported. Safe to call from any goroutine.
+//
+// Example:
+//
+//	for tui.HasRunningOperations() {
+//		time.Sleep(100 * time.Millisecond)
+//	}
+func (h *DevTUI) HasRunningOperations() bool {
+	for _, tab := range h.TabSections {
+		for _, f := range tab.fieldHandlers {
+			if f.IsRunning() {
+				return true
+			}
+		}
+	}
+	return false
+}
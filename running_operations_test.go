@@ -0,0 +1,44 @@
+package devtui
+
+import (
+	"testing"
+	"time"
+)
+
+func TestHasRunningOperations(t *testing.T) {
+	h := NewTUI(&TuiConfig{
+		AppName:  "Running",
+		ExitChan: make(chan bool),
+		Logger:   func(...any) {},
+	})
+	tab := h.NewTabSection("Deploy", "Running operations test")
+	h.AddHandler(NewTestEditableHandler("Host", "localhost"), 0, "", tab)
+	handler := &uploadHandler{reported: make(chan struct{}), release: make(chan struct{})}
+	h.AddHandler(handler, time.Second, "", tab)
+	ts := tab.(*tabSection)
+	f := ts.fieldHandlers[1]
+
+	if h.HasRunningOperations() || f.IsRunning() {
+		t.Fatal("Expected nothing running before any operation")
+	}
+
+	f.handleEnterWithValue("dist")
+	if !h.HasRunningOperations() || !f.IsRunning() {
+		t.Fatal("Expected the operation to count as running as soon as it is started")
+	}
+	if ts.fieldHandlers[0].IsRunning() {
+		t.Error("Expected idle fields not to report running")
+	}
+
+	close(handler.release)
+	deadline := time.Now().Add(time.Second)
+	for h.HasRunningOperations() && time.Now().Before(deadline) {
+		time.Sleep(time.Millisecond)
+	}
+	if h.HasRunningOperations() {
+		t.Fatal("Expected no running operations once the handler finished")
+	}
+	if !tabContains(ts, "assets") {
+		t.Error("Expected the result to be reported before the operation stops running")
+	}
+}
@@ -0,0 +1,52 @@
+package devtui
+
+import (
+	"math"
+	"strings"
+)
+
+// viewportView renders the viewport. When TuiConfig.ShowScrollbar is enabled and the
+// content is taller than the viewport, the last column (the content's right padding)
+// is replaced by a vertical scrollbar.
+func (h *DevTUI) viewportView() string {
+	view := h.viewport.View()
+	if !h.ShowScrollbar || h.viewport.Width < 2 || h.viewport.TotalLineCount() <= h.viewport.Height {
+		return view
+	}
+
+	bar := h.scrollbarColumn()
+	trim := h.newStyle().MaxWidth(h.viewport.Width - 1)
+	lines := strings.Split(view, "\n")
+	for i := range lines {
+		if i < len(bar) {
+			lines[i] = trim.Render(lines[i]) + bar[i]
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+// scrollbarColumn returns one cell per visible row: thumb size reflects the
+// visible/total line ratio and its position follows viewport.ScrollPercent().
+func (h *DevTUI) scrollbarColumn() []string {
+	height := h.viewport.Height
+	total := h.viewport.TotalLineCount()
+	if height <= 0 || total <= 0 {
+		return nil
+	}
+
+	thumbSize := max(1, height*height/total)
+	thumbTop := int(math.Round(h.viewport.ScrollPercent() * float64(height-thumbSize)))
+
+	track := h.scrollTrackStyle.Render("│")
+	thumb := h.scrollThumbStyle.Render("█")
+
+	column := make([]string, height)
+	for i := range column {
+		if i >= thumbTop && i < thumbTop+thumbSize {
+			column[i] = thumb
+		} else {
+			column[i] = track
+		}
+	}
+	return column
+}
@@ -0,0 +1,64 @@
+package devtui
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/charmbracelet/bubbles/viewport"
+)
+
+func setupScrollbarTest(t *testing.T, messages int) *DevTUI {
+	h := NewTUI(&TuiConfig{ExitChan: make(chan bool), ShowScrollbar: true})
+	h.SetTestMode(true)
+	tab := h.NewTabSection("Logs", "Scrollbar test")
+	log := h.AddLogger("Writer", false, "", tab)
+
+	h.viewport = viewport.New(40, 5)
+	h.ready = true
+	h.activeTab = GetFirstTestTabIndex()
+	for i := 0; i < messages; i++ {
+		log("line")
+	}
+	h.updateViewport()
+	return h
+}
+
+func lastCells(view string) string {
+	var cells []string
+	for _, line := range strings.Split(view, "\n") {
+		runes := []rune(line)
+		cells = append(cells, string(runes[len(runes)-1]))
+	}
+	return strings.Join(cells, "")
+}
+
+func TestScrollbarFollowsScrollPosition(t *testing.T) {
+	h := setupScrollbarTest(t, 20)
+
+	// updateViewport scrolls to the bottom: thumb at the end of the track
+	if got := lastCells(h.viewportView()); got != "││││█" {
+		t.Errorf("Expected thumb at bottom '││││█', got %q", got)
+	}
+
+	h.viewport.GotoTop()
+	if got := lastCells(h.viewportView()); got != "█││││" {
+		t.Errorf("Expected thumb at top '█││││', got %q", got)
+	}
+}
+
+func TestScrollbarHiddenWhenContentFits(t *testing.T) {
+	h := setupScrollbarTest(t, 2)
+
+	if view := h.viewportView(); strings.Contains(view, "█") || strings.Contains(view, "│") {
+		t.Errorf("Scrollbar should not be drawn when content fits:\n%s", view)
+	}
+}
+
+func TestScrollbarDisabledByDefault(t *testing.T) {
+	h := setupScrollbarTest(t, 20)
+	h.ShowScrollbar = false
+
+	if view := h.viewportView(); view != h.viewport.View() {
+		t.Error("Expected plain viewport view when ShowScrollbar is false")
+	}
+}
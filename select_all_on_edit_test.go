@@ -0,0 +1,68 @@
+package devtui
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// selectAllHandler opts into select-all per field
+type selectAllHandler struct {
+	*TestEditableHandler
+}
+
+func (s *selectAllHandler) SelectAllOnEdit() bool { return true }
+
+// newSelectAllTest returns a TUI with the Port field (value 8080) in edit mode
+func newSelectAllTest(t *testing.T, configDefault bool, handler HandlerEdit) (*DevTUI, *field) {
+	t.Helper()
+	h := DefaultTUIForTest()
+	h.SelectAllOnEdit = configDefault
+	h.viewport.Width = 80
+	h.viewport.Height = 10
+	tab := h.NewTabSection("Config", "Select all test")
+	h.AddHandler(handler, 0, "", tab)
+	ts := tab.(*tabSection)
+	h.activeTab = ts.index
+	h.handleKeyboard(tea.KeyMsg{Type: tea.KeyEnter})
+	return h, ts.fieldHandlers[0]
+}
+
+func TestSelectAllOnEditReplacesValue(t *testing.T) {
+	h, f := newSelectAllTest(t, true, NewTestEditableHandler("Port", "8080"))
+
+	h.handleKeyboard(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'9'}})
+	if f.tempEditValue != "9" || f.cursor != 1 {
+		t.Errorf("Expected the first keystroke to replace the value with %q, got %q at %d", "9", f.tempEditValue, f.cursor)
+	}
+
+	// Only the first keystroke replaces: the next ones are inserted as usual
+	h.handleKeyboard(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'0'}})
+	if f.tempEditValue != "90" {
+		t.Errorf("Expected %q after the second keystroke, got %q", "90", f.tempEditValue)
+	}
+}
+
+func TestSelectAllOnEditCancelledByArrows(t *testing.T) {
+	h, f := newSelectAllTest(t, true, NewTestEditableHandler("Port", "8080"))
+
+	h.handleKeyboard(tea.KeyMsg{Type: tea.KeyLeft})
+	h.handleKeyboard(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'9'}})
+	if f.tempEditValue != "80890" {
+		t.Errorf("Expected arrows to cancel the selection and insert at the cursor, got %q", f.tempEditValue)
+	}
+}
+
+func TestSelectAllOnEditPerField(t *testing.T) {
+	h, f := newSelectAllTest(t, false, &selectAllHandler{NewTestEditableHandler("Port", "8080")})
+	h.handleKeyboard(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'9'}})
+	if f.tempEditValue != "9" {
+		t.Errorf("Expected the handler's SelectAllOnEdit() to enable replacement, got %q", f.tempEditValue)
+	}
+
+	h, f = newSelectAllTest(t, false, NewTestEditableHandler("Port", "8080"))
+	h.handleKeyboard(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'9'}})
+	if f.tempEditValue != "80809" {
+		t.Errorf("Expected insertion without SelectAllOnEdit, got %q", f.tempEditValue)
+	}
+}
@@ -0,0 +1,54 @@
+package devtui
+
+import . "github.com/cdvelop/tinystring"
+
+// severityFilter hides lower severity messages of a tab (cycled with "e"). Filtered
+// messages are only skipped when rendering, so switching back to all restores them.
+type severityFilter int
+
+const (
+	severityAll      severityFilter = iota // every message
+	severityWarnings                       // warnings and errors
+	severityErrors                         // errors only
+)
+
+// next returns the following filter in the all → warnings+ → errors cycle
+func (s severityFilter) next() severityFilter {
+	return (s + 1) % 3
+}
+
+// allows reports whether a message of type t is shown under the filter
+func (s severityFilter) allows(t MessageType) bool {
+	switch s {
+	case severityWarnings:
+		return t == Msg.Warning || t == Msg.Error
+	case severityErrors:
+		return t == Msg.Error
+	}
+	return true
+}
+
+// label is shown in the footer while a filter is active
+func (s severityFilter) label() string {
+	switch s {
+	case severityWarnings:
+		return "warnings+"
+	case severityErrors:
+		return "errors only"
+	}
+	return ""
+}
+
+// cycleSeverityFilter switches the tab to the next severity filter
+func (ts *tabSection) cycleSeverityFilter() {
+	ts.mu.Lock()
+	ts.severity = ts.severity.next()
+	ts.mu.Unlock()
+}
+
+// severityFilter returns the active severity filter of the tab
+func (ts *tabSection) severityFilter() severityFilter {
+	ts.mu.RLock()
+	defer ts.mu.RUnlock()
+	return ts.severity
+}
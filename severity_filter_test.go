@@ -0,0 +1,81 @@
+package devtui
+
+import (
+	"strings"
+	"testing"
+
+	. "github.com/cdvelop/tinystring"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/x/ansi"
+)
+
+func setupSeverityFilterTest(t *testing.T) (*DevTUI, *tabSection) {
+	t.Helper()
+	h := DefaultTUIForTest()
+	h.viewport.Width = 80
+	h.viewport.Height = 10
+	tab := h.NewTabSection("Logs", "Severity filter test")
+	ts := tab.(*tabSection)
+	h.activeTab = ts.index
+	h.sendMessageWithHandler("server listening", Msg.Info, ts, "Server", "", "")
+	h.sendMessageWithHandler("plain line", Msg.Normal, ts, "Server", "", "")
+	h.sendMessageWithHandler("slow request", Msg.Warning, ts, "Server", "", "")
+	h.sendMessageWithHandler("panic recovered", Msg.Error, ts, "Server", "", "")
+	return h, ts
+}
+
+func pressFilterKey(h *DevTUI) {
+	h.handleKeyboard(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'e'}})
+}
+
+func TestSeverityFilterCyclesAndRestores(t *testing.T) {
+	h, ts := setupSeverityFilterTest(t)
+
+	steps := []struct {
+		label   string
+		visible []string
+		hidden  []string
+	}{
+		{"warnings+", []string{"slow request", "panic recovered"}, []string{"server listening", "plain line"}},
+		{"errors only", []string{"panic recovered"}, []string{"slow request", "server listening"}},
+		{"", []string{"server listening", "plain line", "slow request", "panic recovered"}, nil},
+	}
+	for _, step := range steps {
+		pressFilterKey(h)
+		content := ansi.Strip(h.ContentView())
+		for _, want := range step.visible {
+			if !strings.Contains(content, want) {
+				t.Errorf("Filter %q: expected %q to be shown", step.label, want)
+			}
+		}
+		for _, hidden := range step.hidden {
+			if strings.Contains(content, hidden) {
+				t.Errorf("Filter %q: expected %q to be hidden", step.label, hidden)
+			}
+		}
+		footer := ansi.Strip(h.renderFooterInfo())
+		if step.label != "" && !strings.Contains(footer, step.label) {
+			t.Errorf("Expected the footer to show %q, got %q", step.label, footer)
+		}
+		if step.label == "" && (strings.Contains(footer, "warnings+") || strings.Contains(footer, "errors only")) {
+			t.Errorf("Expected no filter label with all messages shown, got %q", footer)
+		}
+	}
+
+	// Filtering never deletes messages
+	ts.mu.RLock()
+	defer ts.mu.RUnlock()
+	if len(ts.tabContents) != 4 {
+		t.Errorf("Expected all 4 messages retained, got %d", len(ts.tabContents))
+	}
+}
+
+func TestSeverityFilterIsPerTab(t *testing.T) {
+	h, ts := setupSeverityFilterTest(t)
+	other := h.NewTabSection("Other", "Unfiltered").(*tabSection)
+
+	pressFilterKey(h)
+	if ts.severityFilter() != severityWarnings || other.severityFilter() != severityAll {
+		t.Errorf("Expected only the active tab to be filtered, got %v and %v", ts.severityFilter(), other.severityFilter())
+	}
+}
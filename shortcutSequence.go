@@ -0,0 +1,75 @@
+package devtui
+
+import (
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// shortcutSequenceTimeout is how long a key that starts a multi-key shortcut (eg: "g" of
+// "gb") waits for the next key before running on its own
+const shortcutSequenceTimeout = 800 * time.Millisecond
+
+// shortcutTimeoutMsg flushes the pending shortcut keys; id discards timers of older sequences
+type shortcutTimeoutMsg struct {
+	id int
+}
+
+// handleShortcutKey resolves key against the shortcut registry, buffering keys that start
+// a longer registered sequence. consumed is false when key belongs to no shortcut.
+func (h *DevTUI) handleShortcutKey(key string) (cmd tea.Cmd, consumed bool) {
+	if h.pendingKeys != "" {
+		sequence := h.pendingKeys + key
+		if h.shortcutRegistry.hasLongerKey(sequence) {
+			h.pendingKeys = sequence
+			return h.pendingKeysTimeout(), true
+		}
+		if entry, exists := h.shortcutRegistry.Get(sequence); exists {
+			h.pendingKeys = ""
+			_, cmd = h.executeShortcut(entry)
+			return cmd, true
+		}
+		// No sequence continues with key: the buffered keys run on their own
+		// (shortcut or built-in key) and key is handled alone
+		flushCmd, flushed := h.flushPendingKeys()
+		cmd, consumed = h.handleShortcutKey(key)
+		if !consumed {
+			consumed = h.runBuiltinKey(key)
+		}
+		return tea.Batch(flushCmd, cmd), flushed || consumed
+	}
+
+	if h.shortcutRegistry.hasLongerKey(key) {
+		h.pendingKeys = key
+		return h.pendingKeysTimeout(), true
+	}
+	if entry, exists := h.shortcutRegistry.Get(key); exists {
+		_, cmd = h.executeShortcut(entry)
+		return cmd, true
+	}
+	return nil, false
+}
+
+// pendingKeysTimeout schedules the flush of the current pending keys
+func (h *DevTUI) pendingKeysTimeout() tea.Cmd {
+	h.pendingKeysID++
+	id := h.pendingKeysID
+	return tea.Tick(shortcutSequenceTimeout, func(time.Time) tea.Msg {
+		return shortcutTimeoutMsg{id: id}
+	})
+}
+
+// flushPendingKeys clears the pending keys and runs them as a shortcut when registered,
+// otherwise as a built-in key (eg: a lone "g" still jumps to the top when "gb" is registered)
+func (h *DevTUI) flushPendingKeys() (tea.Cmd, bool) {
+	keys := h.pendingKeys
+	h.pendingKeys = ""
+	if keys == "" {
+		return nil, false
+	}
+	if entry, exists := h.shortcutRegistry.Get(keys); exists {
+		_, cmd := h.executeShortcut(entry)
+		return cmd, true
+	}
+	return nil, h.runBuiltinKey(keys)
+}
@@ -68,7 +68,7 @@ func TestShortcutBug(t *testing.T) {
 	time.Sleep(1 * time.Second)
 
 	t.Logf("Step 5: After shortcut - activeTab: %d, DatabaseHandler.LastAction: '%s'",
-		tui.activeTab, databaseHandler.LastAction)
+		tui.activeTab, databaseHandler.Action())
 
 	// Get UI content after shortcut
 	content := tui.ContentView()
@@ -85,8 +85,8 @@ func TestShortcutBug(t *testing.T) {
 	t.Logf("Step 7: Activity count - DatabaseConfig: %d, SystemBackup: %d", databaseCount, backupCount)
 
 	// Expected behavior: Only DatabaseHandler should have executed
-	if databaseHandler.LastAction != "test" {
-		t.Errorf("Expected DatabaseHandler.LastAction to be 'test', got '%s'", databaseHandler.LastAction)
+	if databaseHandler.Action() != "test" {
+		t.Errorf("Expected DatabaseHandler.LastAction to be 'test', got '%s'", databaseHandler.Action())
 	}
 
 	// BUG: If BackupHandler also shows activity, that's the bug
@@ -0,0 +1,105 @@
+package devtui
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// sequenceShortcutHandler registers single and multi-key shortcuts and records the values received
+type sequenceShortcutHandler struct {
+	values []string
+}
+
+func (h *sequenceShortcutHandler) Name() string  { return "Builder" }
+func (h *sequenceShortcutHandler) Label() string { return "Build" }
+func (h *sequenceShortcutHandler) Value() string { return "" }
+func (h *sequenceShortcutHandler) Change(newValue string, progress chan<- string) {
+	h.values = append(h.values, newValue)
+}
+func (h *sequenceShortcutHandler) Shortcuts() []map[string]string {
+	return []map[string]string{
+		{"gb": "go build"},
+		{"gt": "go test"},
+		{"g": "git status"},
+		{"x": "clean"},
+	}
+}
+
+func setupSequenceShortcutTest(t *testing.T) (*DevTUI, *sequenceShortcutHandler) {
+	t.Helper()
+	h := DefaultTUIForTest()
+	h.viewport.Width = 80
+	h.viewport.Height = 10
+	handler := &sequenceShortcutHandler{}
+	h.AddHandler(handler, 0, "", h.NewTabSection("Build", "Sequence shortcut test"))
+	return h, handler
+}
+
+func pressRune(h *DevTUI, r rune) tea.Cmd {
+	_, cmd := h.handleKeyboard(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{r}})
+	return cmd
+}
+
+func TestShortcutSequenceDispatchesFullKey(t *testing.T) {
+	h, handler := setupSequenceShortcutTest(t)
+
+	if cmd := pressRune(h, 'g'); cmd == nil {
+		t.Fatal("Expected a timeout command while waiting for the next key")
+	}
+	if len(handler.values) != 0 {
+		t.Fatalf("Expected prefix key to wait for the sequence, got %v", handler.values)
+	}
+
+	pressRune(h, 'b')
+	if len(handler.values) != 1 || handler.values[0] != "gb" {
+		t.Fatalf("Expected sequence \"gb\" to run, got %v", handler.values)
+	}
+	if h.pendingKeys != "" {
+		t.Errorf("Expected pending keys to be cleared, got %q", h.pendingKeys)
+	}
+}
+
+func TestShortcutSequenceTimeoutRunsPrefix(t *testing.T) {
+	h, handler := setupSequenceShortcutTest(t)
+
+	pressRune(h, 'g')
+	h.Update(shortcutTimeoutMsg{id: h.pendingKeysID})
+
+	if len(handler.values) != 1 || handler.values[0] != "g" {
+		t.Fatalf("Expected timeout to fall back to single key \"g\", got %v", handler.values)
+	}
+
+	// A stale timer from an earlier sequence must not flush a newer one
+	pressRune(h, 'g')
+	h.Update(shortcutTimeoutMsg{id: h.pendingKeysID - 1})
+	if h.pendingKeys != "g" || len(handler.values) != 1 {
+		t.Errorf("Expected stale timeout to be ignored, pending %q values %v", h.pendingKeys, handler.values)
+	}
+}
+
+func TestShortcutSequenceNonMatchingKey(t *testing.T) {
+	h, handler := setupSequenceShortcutTest(t)
+
+	pressRune(h, 'g')
+	pressRune(h, 'x')
+
+	if len(handler.values) != 2 || handler.values[0] != "g" || handler.values[1] != "x" {
+		t.Fatalf("Expected \"g\" then \"x\" as single keys, got %v", handler.values)
+	}
+	if h.pendingKeys != "" {
+		t.Errorf("Expected pending keys to be cleared, got %q", h.pendingKeys)
+	}
+}
+
+func TestShortcutSequenceCancelledByOtherKey(t *testing.T) {
+	h, handler := setupSequenceShortcutTest(t)
+
+	pressRune(h, 'g')
+	h.handleKeyboard(tea.KeyMsg{Type: tea.KeyPgDown})
+	h.Update(shortcutTimeoutMsg{id: h.pendingKeysID})
+
+	if h.pendingKeys != "" || len(handler.values) != 0 {
+		t.Errorf("Expected non-rune key to cancel the sequence, pending %q values %v", h.pendingKeys, handler.values)
+	}
+}
@@ -2,6 +2,8 @@ package devtui
 
 // createShortcutsTab creates and registers the shortcuts tab with its handler
 import (
+	"strings"
+
 	. "github.com/cdvelop/tinystring"
 )
 
@@ -64,7 +66,11 @@ func (h *shortcutsInteractiveHandler) WaitingForUser() bool {
 
 // generateHelpContent creates the help content string
 func (h *shortcutsInteractiveHandler) generateHelpContent() string {
-	content := Translate(h.appName, D.Shortcuts, D.Keyboard, `:
+	appName := h.appName
+	if h.tui != nil {
+		appName = h.tui.appTitle() // follows SetTitle
+	}
+	content := Translate(appName, D.Shortcuts, D.Keyboard, `:
 
 `, D.Content, D.Tab, `:
   • Tab/Shift+Tab  -`, D.Switch, D.Content, `
@@ -77,10 +83,21 @@ func (h *shortcutsInteractiveHandler) generateHelpContent() string {
 `, D.Edit, D.Text, `:
   • `, D.Arrow, D.Left, `/`, D.Right, `   -`, D.Move, `cursor
   • Backspace      			-`, D.Create, D.Space, `
+  • Ctrl+R         - `, terms.Reset, terms.Default, D.Value, `
+  • Ctrl+Z/Ctrl+Y  - `, terms.Undo, `/`, terms.Redo, `
 
 Viewport:
   • `, D.Arrow, D.Up, "/", D.Down, `    - Scroll`, D.Line, D.Text, `
   • PgUp/PgDown    		- Scroll`, D.Page, `
+  • Ctrl+U/Ctrl+D  - `, terms.Scroll, terms.Half, D.Page, `
+  • Ctrl+Home/End  - `, D.Begin, `/`, D.End, ` (Home/End, g/G)
+  • Ctrl+T         - `, D.Switch, D.Time, `
+  • Ctrl+N         - `, D.Switch, D.Handler, `
+  • Shift+Y        - `, terms.Copy, D.Tab, D.Content, `
+  • Ctrl+L         - `, terms.Clear, D.Tab, D.Content, `
+  • F1 / ?         - `, terms.Help, `
+  • e              - `, terms.Filter, `: `, D.All, `/`, terms.Warnings, `/`, terms.Errors, `
+  • Space          - `, terms.Pause, `/`, terms.Resume, terms.Output, ` -`, D.Tab, terms.Without, D.Fields, `
   • Mouse Wheel    		- Scroll`, D.Page, `
 
 Scroll `, D.Status, D.Icons, `:
@@ -93,29 +110,37 @@ Scroll `, D.Status, D.Icons, `:
   • Ctrl+C         - `, D.Quit, `
 `).String()
 
-	// NEW: Add registered shortcuts section
-	if h.tui != nil && h.tui.shortcutRegistry != nil {
-		shortcuts := h.getRegisteredShortcuts()
-		if len(shortcuts) > 0 {
-			content += "\n\nRegistered Shortcuts:\n"
-			for key, description := range shortcuts {
-				content += Fmt("  • %s - %s\n", key, description)
-			}
-		}
+	// Live list of shortcuts registered by handlers (ShortcutProvider)
+	if lines := h.registeredShortcutLines(); len(lines) > 0 {
+		content += "\n\nRegistered Shortcuts:\n" + strings.Join(lines, "\n") + "\n"
 	}
 
 	content += "\n" + Translate(D.Language, D.Supported, `: en, es, zh, hi, ar, pt, fr, de, ru`).String()
 	return content
 }
 
-// getRegisteredShortcuts returns all registered shortcuts with descriptions
-func (h *shortcutsInteractiveHandler) getRegisteredShortcuts() map[string]string {
-	shortcuts := make(map[string]string)
-	if h.tui != nil && h.tui.shortcutRegistry != nil {
-		allEntries := h.tui.shortcutRegistry.GetAll()
-		for key, entry := range allEntries {
-			shortcuts[key] = entry.Description
+// registeredShortcutLines lists the registry entries read at display time
+func (h *shortcutsInteractiveHandler) registeredShortcutLines() []string {
+	if h.tui == nil {
+		return nil
+	}
+	return h.tui.shortcutLines()
+}
+
+// shortcutLines lists the registered shortcuts ordered by tab, field and key:
+// "  • t - test connection (Config › DatabaseConfig)"
+func (h *DevTUI) shortcutLines() []string {
+	if h.shortcutRegistry == nil {
+		return nil
+	}
+	entries := h.shortcutRegistry.sorted()
+	lines := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		tabTitle := "?"
+		if entry.TabIndex < len(h.TabSections) {
+			tabTitle = h.TabSections[entry.TabIndex].currentTitle()
 		}
+		lines = append(lines, Fmt("  • %s - %s (%s › %s)", entry.Key, entry.Description, tabTitle, entry.HandlerName))
 	}
-	return shortcuts
+	return lines
 }
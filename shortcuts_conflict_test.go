@@ -0,0 +1,99 @@
+package devtui
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cdvelop/devtui/example"
+)
+
+// setupShortcutConflict registers two handlers claiming the same keys in tabs 1 and 2
+func setupShortcutConflict(policy ShortcutConflictPolicy) *DevTUI {
+	h := NewTUI(&TuiConfig{
+		AppName:                "Conflicts",
+		ExitChan:               make(chan bool),
+		ShortcutConflictPolicy: policy,
+		Logger:                 func(messages ...any) {},
+	})
+	h.SetTestMode(true)
+
+	first := h.NewTabSection("First", "Conflict test")
+	h.AddHandler(&example.DatabaseHandler{}, time.Second, "", first)
+	second := h.NewTabSection("Second", "Conflict test")
+	h.AddHandler(&example.DatabaseHandler{}, time.Second, "", second)
+	return h
+}
+
+func TestShortcutConflictPolicy(t *testing.T) {
+	cases := []struct {
+		name    string
+		policy  ShortcutConflictPolicy
+		wantTab int
+	}{
+		{"last wins (default)", ShortcutLastWins, 2},
+		{"first wins", ShortcutFirstWins, 1},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			h := setupShortcutConflict(c.policy)
+			entry, exists := h.shortcutRegistry.Get("t")
+			if !exists {
+				t.Fatal("Expected shortcut 't' to be registered")
+			}
+			if entry.TabIndex != c.wantTab {
+				t.Errorf("Expected 't' bound to tab %d, got %d", c.wantTab, entry.TabIndex)
+			}
+		})
+	}
+}
+
+func TestShortcutConflictLogged(t *testing.T) {
+	var logs []string
+	h := NewTUI(&TuiConfig{
+		AppName:  "Conflicts",
+		ExitChan: make(chan bool),
+		Logger: func(messages ...any) {
+			for _, m := range messages {
+				if s, ok := m.(string); ok {
+					logs = append(logs, s)
+				}
+			}
+		},
+	})
+	h.SetTestMode(true)
+	for _, title := range []string{"First", "Second"} {
+		h.AddHandler(&example.DatabaseHandler{}, time.Second, "", h.NewTabSection(title, "Conflict test"))
+	}
+
+	found := false
+	for _, l := range logs {
+		if strings.Contains(l, `Shortcut conflict: "t"`) {
+			found = true
+		}
+	}
+	if !found {
+		t.Errorf("Expected conflict on 't' to be logged, got %v", logs)
+	}
+}
+
+func TestShortcutsAudit(t *testing.T) {
+	h := setupShortcutConflict(ShortcutFirstWins)
+	shortcuts := h.Shortcuts()
+	if len(shortcuts) == 0 {
+		t.Fatal("Expected registered shortcuts")
+	}
+	for i := 1; i < len(shortcuts); i++ {
+		prev, cur := shortcuts[i-1], shortcuts[i]
+		if prev.TabIndex > cur.TabIndex || (prev.TabIndex == cur.TabIndex && prev.FieldIndex == cur.FieldIndex && prev.Key > cur.Key) {
+			t.Errorf("Expected shortcuts ordered by tab, field and key, got %+v before %+v", prev, cur)
+		}
+	}
+
+	// Returned entries are copies
+	shortcuts[0].Description = "changed"
+	if entry, _ := h.shortcutRegistry.Get(shortcuts[0].Key); entry.Description == "changed" {
+		t.Error("Expected Shortcuts() to return copies of the registry entries")
+	}
+}
@@ -0,0 +1,100 @@
+package devtui
+
+import (
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// shortcutExecutionHandler is an execution handler exposing a global shortcut
+type shortcutExecutionHandler struct {
+	runs int
+}
+
+func (h *shortcutExecutionHandler) Name() string  { return "RunTests" }
+func (h *shortcutExecutionHandler) Label() string { return "Run tests" }
+func (h *shortcutExecutionHandler) Execute(progress chan<- string) {
+	h.runs++
+	progress <- "tests passed"
+}
+func (h *shortcutExecutionHandler) Shortcuts() []map[string]string {
+	return []map[string]string{{"r": "run tests"}}
+}
+
+func TestShortcutRunsExecutionHandler(t *testing.T) {
+	h := DefaultTUIForTest()
+	h.viewport.Width = 80
+	h.viewport.Height = 10
+
+	handler := &shortcutExecutionHandler{}
+	tab := h.NewTabSection("Build", "Execution shortcut test")
+	h.AddHandler(NewTestEditableHandler("Other", "x"), 0, "", tab)
+	h.AddHandler(handler, 0, "", tab)
+	h.activeTab = 0 // start away from the target tab
+
+	entry, exists := h.shortcutRegistry.Get("r")
+	if !exists {
+		t.Fatal("Expected execution handler shortcut 'r' to be registered")
+	}
+	if entry.HandlerName != "RunTests" || entry.FieldIndex != 1 {
+		t.Errorf("Unexpected shortcut entry: %+v", entry)
+	}
+
+	h.handleKeyboard(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'r'}})
+
+	if handler.runs != 1 {
+		t.Errorf("Expected shortcut to run Execute once, ran %d times", handler.runs)
+	}
+	ts := h.TabSections[GetFirstTestTabIndex()]
+	if h.activeTab != GetFirstTestTabIndex() || ts.indexActiveEditField != 1 {
+		t.Errorf("Expected shortcut to select the execution field, got tab %d field %d", h.activeTab, ts.indexActiveEditField)
+	}
+}
+
+// slowShortcutHandler is an edit handler whose shortcut triggers a blocking Change
+type slowShortcutHandler struct {
+	slowEditHandler
+	changed chan string
+}
+
+func (h *slowShortcutHandler) Change(newValue string, progress chan<- string) {
+	h.slowEditHandler.Change(newValue, progress)
+	h.changed <- newValue
+}
+
+func (h *slowShortcutHandler) Shortcuts() []map[string]string {
+	return []map[string]string{{"b": "build"}}
+}
+
+func TestShortcutRunsAsynchronously(t *testing.T) {
+	h := NewTUI(&TuiConfig{
+		AppName:  "AsyncShortcut",
+		ExitChan: make(chan bool),
+		Logger:   func(messages ...any) {},
+	})
+	h.viewport.Width = 80
+	h.viewport.Height = 10
+
+	handler := &slowShortcutHandler{
+		slowEditHandler: slowEditHandler{delay: 300 * time.Millisecond},
+		changed:         make(chan string, 1),
+	}
+	tab := h.NewTabSection("Build", "Async shortcut test")
+	h.AddHandler(handler, 5*time.Second, "", tab)
+
+	start := time.Now()
+	h.handleKeyboard(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'b'}})
+	if elapsed := time.Since(start); elapsed > 150*time.Millisecond {
+		t.Errorf("Expected shortcut to return without waiting for Change, blocked %v", elapsed)
+	}
+
+	select {
+	case value := <-handler.changed:
+		if value != "b" {
+			t.Errorf("Expected shortcut value 'b', got %q", value)
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("Expected Change to run in the background")
+	}
+}
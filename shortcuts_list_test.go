@@ -0,0 +1,63 @@
+package devtui
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cdvelop/devtui/example"
+	. "github.com/cdvelop/tinystring"
+)
+
+func TestShortcutsTabListsRegisteredShortcuts(t *testing.T) {
+	h := DefaultTUIForTest()
+	guide := h.TabSections[0].fieldHandlers[0].handler.origHandler.(*shortcutsInteractiveHandler)
+
+	if strings.Contains(guide.generateHelpContent(), "Registered Shortcuts") {
+		t.Fatal("Expected no registered shortcuts section before handlers register any")
+	}
+
+	config := h.NewTabSection("Config", "Shortcut list test")
+	h.AddHandler(&example.DatabaseHandler{}, time.Second, "", config)
+	build := h.NewTabSection("Build", "Shortcut list test")
+	h.AddHandler(&shortcutExecutionHandler{}, 0, "", build)
+
+	content := guide.generateHelpContent()
+	if !strings.Contains(content, "Ctrl+C") {
+		t.Errorf("Expected static navigation help to be kept, got:\n%s", content)
+	}
+
+	listAt := strings.Index(content, "Registered Shortcuts")
+	if listAt < 0 || listAt < strings.Index(content, "Ctrl+C") {
+		t.Fatalf("Expected registered shortcuts after the static help, got:\n%s", content)
+	}
+	list := content[listAt:]
+	for _, want := range []string{
+		"• t - test connection (Config › DatabaseConfig)",
+		"• r - run tests (Build › RunTests)",
+	} {
+		if !strings.Contains(list, want) {
+			t.Errorf("Expected %q in registered shortcuts, got:\n%s", want, list)
+		}
+	}
+	if strings.Index(list, "Config ›") > strings.Index(list, "Build ›") {
+		t.Errorf("Expected shortcuts ordered by tab, got:\n%s", list)
+	}
+}
+
+func TestShortcutsHelpTranslatesKeyBindings(t *testing.T) {
+	OutLang(ES)
+	defer OutLang(EN)
+
+	content := (&shortcutsInteractiveHandler{appName: "TestApp"}).generateHelpContent()
+	for _, want := range []string{"Deshacer/Rehacer", "Copiar Pestaña Contenido", "Limpiar Pestaña Contenido", "Ayuda", "Pausar/Reanudar Salida"} {
+		if !strings.Contains(content, want) {
+			t.Errorf("Expected translated help line %q, got:\n%s", want, content)
+		}
+	}
+	for _, english := range []string{"Undo", "Copy", "Clear", "Help overlay", "Pause/resume"} {
+		if strings.Contains(content, english) {
+			t.Errorf("Expected no hardcoded English %q in the Spanish help", english)
+		}
+	}
+}
@@ -1,6 +1,10 @@
 package devtui
 
-import "sync"
+import (
+	"sort"
+	"strings"
+	"sync"
+)
 
 // ShortcutEntry represents a registered shortcut
 type ShortcutEntry struct {
@@ -12,6 +16,14 @@ type ShortcutEntry struct {
 	Value       string // Value to pass to Change()
 }
 
+// ShortcutConflictPolicy decides which handler keeps a shortcut key claimed twice
+type ShortcutConflictPolicy int
+
+const (
+	ShortcutLastWins  ShortcutConflictPolicy = iota // the last registered handler takes the key (default)
+	ShortcutFirstWins                               // the first registered handler keeps the key
+)
+
 // ShortcutRegistry manages global shortcut keys
 type ShortcutRegistry struct {
 	mu        sync.RWMutex
@@ -30,6 +42,19 @@ func (sr *ShortcutRegistry) Register(key string, entry *ShortcutEntry) {
 	sr.shortcuts[key] = entry
 }
 
+// registerWithPolicy registers entry unless its key is taken and policy is ShortcutFirstWins.
+// It returns the entry previously bound to the key (nil when free) and whether entry was stored.
+func (sr *ShortcutRegistry) registerWithPolicy(entry *ShortcutEntry, policy ShortcutConflictPolicy) (previous *ShortcutEntry, stored bool) {
+	sr.mu.Lock()
+	defer sr.mu.Unlock()
+	previous = sr.shortcuts[entry.Key]
+	if previous != nil && policy == ShortcutFirstWins {
+		return previous, false
+	}
+	sr.shortcuts[entry.Key] = entry
+	return previous, true
+}
+
 func (sr *ShortcutRegistry) Get(key string) (*ShortcutEntry, bool) {
 	sr.mu.RLock()
 	defer sr.mu.RUnlock()
@@ -37,6 +62,19 @@ func (sr *ShortcutRegistry) Get(key string) (*ShortcutEntry, bool) {
 	return entry, exists
 }
 
+// hasLongerKey reports whether a registered key starts with prefix and is longer than it,
+// ie: prefix is the start of a multi-key sequence
+func (sr *ShortcutRegistry) hasLongerKey(prefix string) bool {
+	sr.mu.RLock()
+	defer sr.mu.RUnlock()
+	for key := range sr.shortcuts {
+		if len(key) > len(prefix) && strings.HasPrefix(key, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
 func (sr *ShortcutRegistry) Unregister(key string) {
 	sr.mu.Lock()
 	defer sr.mu.Unlock()
@@ -64,3 +102,36 @@ func (sr *ShortcutRegistry) GetAll() map[string]*ShortcutEntry {
 	}
 	return result
 }
+
+// sorted returns the registered entries ordered by tab, field and key
+func (sr *ShortcutRegistry) sorted() []*ShortcutEntry {
+	sr.mu.RLock()
+	entries := make([]*ShortcutEntry, 0, len(sr.shortcuts))
+	for _, entry := range sr.shortcuts {
+		entries = append(entries, entry)
+	}
+	sr.mu.RUnlock()
+
+	sort.Slice(entries, func(i, j int) bool {
+		a, b := entries[i], entries[j]
+		if a.TabIndex != b.TabIndex {
+			return a.TabIndex < b.TabIndex
+		}
+		if a.FieldIndex != b.FieldIndex {
+			return a.FieldIndex < b.FieldIndex
+		}
+		return a.Key < b.Key
+	})
+	return entries
+}
+
+// Shortcuts returns a copy of every registered shortcut ordered by tab, field and key,
+// so apps can audit their bindings.
+func (h *DevTUI) Shortcuts() []ShortcutEntry {
+	entries := h.shortcutRegistry.sorted()
+	result := make([]ShortcutEntry, len(entries))
+	for i, entry := range entries {
+		result[i] = *entry
+	}
+	return result
+}
@@ -0,0 +1,62 @@
+package devtui
+
+import (
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// sidebarMarker marks the active field in the sidebar, visible even without colors
+const sidebarMarker = "▸ "
+
+// SetTabSidebar enables or disables a left sidebar listing every field label of the
+// tab with the active one highlighted; the content is rendered to its right.
+//
+// Example:
+//
+//	config := tui.NewTabSection("CONFIG", "Server settings")
+//	tui.SetTabSidebar(config, true)
+func (t *DevTUI) SetTabSidebar(tabSection any, enabled bool) {
+	ts := t.validateTabSection(tabSection, "SetTabSidebar")
+	ts.sidebarFields = enabled
+}
+
+// sidebarWidth is the width of the field sidebar column
+func (h *DevTUI) sidebarWidth() int {
+	return h.labelWidth + lipgloss.Width(sidebarMarker) + 1
+}
+
+// renderFieldSidebar lists the tab field labels (name when the label is empty),
+// highlighting the active field
+func (h *DevTUI) renderFieldSidebar(section *tabSection) string {
+	width := h.sidebarWidth()
+	markerWidth := lipgloss.Width(sidebarMarker)
+	lines := make([]string, 0, len(section.fieldHandlers))
+	for i, f := range section.fieldHandlers {
+		label := f.handler.Label()
+		if label == "" {
+			label = f.handler.Name()
+		}
+		label = h.truncate(label, width-markerWidth-1)
+
+		if i == section.indexActiveEditField {
+			lines = append(lines, h.sidebarActiveStyle.Width(width).Render(sidebarMarker+label))
+		} else {
+			lines = append(lines, h.sidebarItemStyle.Width(width).Render(strings.Repeat(" ", markerWidth)+label))
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+// withFieldSidebar places the field sidebar left of content, wrapping content to the remaining width
+func (h *DevTUI) withFieldSidebar(section *tabSection, content string) string {
+	if !section.sidebarFields || len(section.fieldHandlers) == 0 {
+		return content
+	}
+	sidebar := h.renderFieldSidebar(section)
+	contentWidth := h.viewport.Width - lipgloss.Width(sidebar)
+	if contentWidth < 1 {
+		return content
+	}
+	return lipgloss.JoinHorizontal(lipgloss.Top, sidebar, h.newStyle().Width(contentWidth).Render(content))
+}
@@ -0,0 +1,50 @@
+package devtui
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+func TestTabSidebarListsFieldsAndHighlightsActive(t *testing.T) {
+	h := DefaultTUIForTest()
+	h.viewport.Width = 80
+	h.viewport.Height = 20
+
+	tab := h.NewTabSection("Config", "Sidebar test")
+	h.AddHandler(NewTestEditableHandler("Host", "localhost"), 0, "", tab)
+	h.AddHandler(NewTestEditableHandler("Port", "8080"), 0, "", tab)
+	h.AddHandler(NewTestNonEditableHandler("Restart", "Restart server"), 0, "", tab)
+	h.SetTabSidebar(tab, true)
+	h.activeTab = GetFirstTestTabIndex()
+	ts := tab.(*tabSection)
+	ts.indexActiveEditField = 1
+
+	out := h.ContentView()
+	lines := strings.Split(out, "\n")
+	if len(lines) < 3 {
+		t.Fatalf("Expected a sidebar line per field, got:\n%s", out)
+	}
+	for i, label := range []string{"Host", "Port", "Restart"} {
+		if !strings.Contains(lines[i], label) {
+			t.Errorf("Expected sidebar line %d to list %q, got %q", i, label, lines[i])
+		}
+	}
+	if !strings.Contains(lines[1], sidebarMarker+"Port") {
+		t.Errorf("Expected active field Port to be highlighted, got %q", lines[1])
+	}
+	if strings.Contains(lines[0], sidebarMarker) || strings.Contains(lines[2], sidebarMarker) {
+		t.Errorf("Expected only the active field to be highlighted, got:\n%s", out)
+	}
+	for _, line := range lines {
+		if w := lipgloss.Width(line); w > h.viewport.Width {
+			t.Errorf("Line width %d exceeds viewport width %d: %q", w, h.viewport.Width, line)
+		}
+	}
+
+	h.SetTabSidebar(tab, false)
+	if out := h.ContentView(); strings.Contains(out, sidebarMarker) {
+		t.Errorf("Expected no sidebar when disabled, got:\n%s", out)
+	}
+}
@@ -0,0 +1,72 @@
+package devtui
+
+import (
+	"io"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// newHeadlessTUI returns a TUI whose tea program has no terminal input/output,
+// so Start and StartAsync can run inside tests.
+func newHeadlessTUI(config *TuiConfig) *DevTUI {
+	h := NewTUI(config)
+	h.SetTestMode(true)
+	h.tea = tea.NewProgram(h, tea.WithInput(nil), tea.WithOutput(io.Discard), tea.WithoutSignalHandler())
+	return h
+}
+
+func TestStartAsyncStop(t *testing.T) {
+	exitChan := make(chan bool)
+	h := newHeadlessTUI(&TuiConfig{
+		AppName:  "StartAsync",
+		ExitChan: exitChan,
+		Logger:   func(...any) {},
+	})
+
+	stopped := make(chan struct{})
+	go func() {
+		stop := h.StartAsync()
+		stop()
+		stop() // double stop must be safe and return immediately
+		close(stopped)
+	}()
+
+	select {
+	case <-stopped:
+	case <-time.After(5 * time.Second):
+		t.Fatal("stop did not return: tea program did not exit")
+	}
+
+	select {
+	case <-exitChan:
+	default:
+		t.Error("Expected stop to close ExitChan")
+	}
+	if h.running.Load() {
+		t.Error("Expected tea program to have fully exited after stop")
+	}
+}
+
+func TestStartAsyncStopAfterRunning(t *testing.T) {
+	h := newHeadlessTUI(&TuiConfig{
+		AppName:  "StartAsync",
+		ExitChan: make(chan bool),
+		Logger:   func(...any) {},
+	})
+
+	stop := h.StartAsync()
+	waitFor(t, h.running.Load)
+
+	done := make(chan struct{})
+	go func() {
+		stop()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("stop did not return for a running program")
+	}
+}
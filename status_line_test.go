@@ -0,0 +1,67 @@
+package devtui
+
+import (
+	"strings"
+	"sync"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestTabStatusLine(t *testing.T) {
+	h := DefaultTUIForTest()
+	h.viewport.Width = 80
+
+	tab := h.NewTabSection("Server", "HTTP")
+	h.AddHandler(NewTestEditableHandler("Port", "8080"), 0, "", tab)
+	ts := tab.(*tabSection)
+	h.activeTab = ts.index
+
+	before := h.footerView()
+	if strings.Count(before, "\n") != 0 {
+		t.Fatalf("Footer without status should be a single line, got %q", before)
+	}
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		ts.SetStatus("Server: running on :8080")
+	}()
+	wg.Wait()
+
+	footer := h.footerView()
+	lines := strings.Split(footer, "\n")
+	if len(lines) != 2 || !strings.Contains(lines[1], "Server: running on :8080") {
+		t.Fatalf("Expected status below the footer bar, got %q", footer)
+	}
+	if !strings.Contains(lines[0], "8080") {
+		t.Errorf("Input field should still render in the footer bar, got %q", lines[0])
+	}
+
+	// Editing keeps working with the status visible
+	h.handleKeyboard(tea.KeyMsg{Type: tea.KeyEnter})
+	if !h.editModeActivated {
+		t.Error("Expected edit mode to activate with a status line present")
+	}
+
+	ts.SetStatus("")
+	if strings.Contains(h.footerView(), "Server:") {
+		t.Error("Empty status should hide the status line")
+	}
+}
+
+func TestStatusLineShrinksViewport(t *testing.T) {
+	h := DefaultTUIForTest()
+	tab := h.NewTabSection("Server", "HTTP")
+	h.activeTab = tab.(*tabSection).index
+	h.Update(tea.WindowSizeMsg{Width: 80, Height: 24})
+	h.View()
+	height := h.viewport.Height
+
+	tab.(*tabSection).SetStatus("running")
+	h.View()
+	if h.viewport.Height != height-1 {
+		t.Errorf("Expected viewport height %d with status line, got %d", height-1, h.viewport.Height)
+	}
+}
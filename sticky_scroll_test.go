@@ -0,0 +1,123 @@
+package devtui
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/charmbracelet/bubbles/viewport"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func setupStickyScrollTest() (*DevTUI, func(message ...any)) {
+	h := DefaultTUIForTest()
+	tab := h.NewTabSection("Logs", "Sticky scroll")
+	log := h.AddLogger("Writer", false, "", tab)
+
+	h.viewport = viewport.New(40, 5)
+	h.ready = true
+	h.activeTab = tab.(*tabSection).index
+	for i := 0; i < 30; i++ {
+		log("history line", i)
+	}
+	h.updateViewport()
+	return h, log
+}
+
+func TestStickyScrollPreservesOffsetWhenScrolledUp(t *testing.T) {
+	h, log := setupStickyScrollTest()
+	if !h.viewport.AtBottom() {
+		t.Fatal("Expected viewport to start at the bottom")
+	}
+
+	h.viewport.LineUp(10)
+	offset := h.viewport.YOffset
+
+	log("new message")
+	h.updateViewport()
+
+	if h.viewport.YOffset != offset {
+		t.Errorf("Expected offset %d to be preserved while scrolled up, got %d", offset, h.viewport.YOffset)
+	}
+	if info := h.renderScrollInfo(); !strings.Contains(info, "new") {
+		t.Errorf("Expected 'new messages' indicator in footer, got %q", info)
+	}
+
+	// Back at the bottom the viewport follows new content again
+	h.viewport.GotoBottom()
+	log("another message")
+	h.updateViewport()
+	if !h.viewport.AtBottom() {
+		t.Error("Expected viewport to follow new content when at the bottom")
+	}
+	if info := h.renderScrollInfo(); strings.Contains(info, "new") {
+		t.Errorf("Indicator should clear at the bottom, got %q", info)
+	}
+}
+
+func TestAlwaysFollowJumpsToBottom(t *testing.T) {
+	h, log := setupStickyScrollTest()
+	h.AlwaysFollow = true
+
+	h.viewport.LineUp(10)
+	log("new message")
+	h.updateViewport()
+
+	if !h.viewport.AtBottom() {
+		t.Error("Expected AlwaysFollow to scroll to the newest message")
+	}
+}
+
+func TestNewMessagesIndicatorCountsAndJumps(t *testing.T) {
+	h, log := setupStickyScrollTest()
+	h.viewport.LineUp(10)
+
+	log("new 1")
+	h.updateViewport()
+	if info := h.renderScrollInfo(); !strings.Contains(info, "↓ 1 new") {
+		t.Errorf("Expected '↓ 1 new' indicator, got %q", info)
+	}
+
+	log("new 2")
+	log("new 3")
+	h.updateViewport()
+	if h.newMessagesBelow != 3 {
+		t.Errorf("Expected 3 new messages below, got %d", h.newMessagesBelow)
+	}
+	if info := h.renderScrollInfo(); !strings.Contains(info, "↓ 3 new") {
+		t.Errorf("Expected '↓ 3 new' indicator, got %q", info)
+	}
+
+	// Redraws without new messages keep the count
+	h.updateViewport()
+	if h.newMessagesBelow != 3 {
+		t.Errorf("Expected count to stay at 3 without new messages, got %d", h.newMessagesBelow)
+	}
+
+	h.handleKeyboard(tea.KeyMsg{Type: tea.KeyEnd})
+	if !h.viewport.AtBottom() {
+		t.Error("Expected End to jump to the newest message")
+	}
+	if h.newMessagesBelow != 0 || strings.Contains(h.renderScrollInfo(), "new") {
+		t.Errorf("Expected indicator cleared after the jump, count %d", h.newMessagesBelow)
+	}
+
+	// Scrolling up again only counts messages arrived after the jump
+	h.viewport.LineUp(5)
+	log("new 4")
+	h.updateViewport()
+	if h.newMessagesBelow != 1 {
+		t.Errorf("Expected 1 new message after the jump, got %d", h.newMessagesBelow)
+	}
+}
+
+func TestNewMessagesFormatConfigurable(t *testing.T) {
+	h, log := setupStickyScrollTest()
+	h.NewMessagesFormat = "%d more"
+	h.viewport.LineUp(10)
+
+	log("new 1")
+	h.updateViewport()
+	if info := h.renderScrollInfo(); !strings.Contains(info, "1 more") {
+		t.Errorf("Expected custom indicator '1 more', got %q", info)
+	}
+}
@@ -0,0 +1,82 @@
+package devtui
+
+import (
+	"sync"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestStopClosesExitChanOnce(t *testing.T) {
+	exitChan := make(chan bool)
+	h := NewTUI(&TuiConfig{ExitChan: exitChan})
+	h.SetTestMode(true)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			h.Stop()
+		}()
+	}
+	wg.Wait()
+
+	select {
+	case <-exitChan:
+	default:
+		t.Fatal("Expected ExitChan to be closed after Stop")
+	}
+
+	// Ctrl+C after Stop must not panic on double close
+	if _, cmd := h.handleKeyboard(tea.KeyMsg{Type: tea.KeyCtrlC}); cmd == nil {
+		t.Error("Expected quit command after Ctrl+C")
+	}
+}
+
+func TestStopWithoutExitChan(t *testing.T) {
+	h := NewTUI(&TuiConfig{})
+	h.SetTestMode(true)
+	h.Stop()
+	h.Stop()
+}
+
+func TestCtrlCTwiceDoesNotPanic(t *testing.T) {
+	h := DefaultTUIForTest()
+	h.NewTabSection("Tab", "Ctrl+C")
+
+	h.handleKeyboard(tea.KeyMsg{Type: tea.KeyCtrlC})
+	h.handleKeyboard(tea.KeyMsg{Type: tea.KeyCtrlC})
+
+	select {
+	case <-h.ExitChan:
+	default:
+		t.Fatal("Expected ExitChan to be closed after Ctrl+C")
+	}
+}
+
+func TestStopKeepsPendingExitChanValue(t *testing.T) {
+	exitChan := make(chan bool, 1)
+	h := NewTUI(&TuiConfig{ExitChan: exitChan})
+	h.SetTestMode(true)
+
+	exitChan <- true // value sent by the app before shutdown
+	h.Stop()
+
+	if v, ok := <-exitChan; !ok || !v {
+		t.Fatal("Expected Stop to leave the pending ExitChan value in place")
+	}
+	if _, ok := <-exitChan; ok {
+		t.Fatal("Expected ExitChan to be closed after Stop")
+	}
+}
+
+func TestCtrlCAfterAppClosedExitChan(t *testing.T) {
+	exitChan := make(chan bool)
+	h := NewTUI(&TuiConfig{ExitChan: exitChan})
+	h.SetTestMode(true)
+
+	close(exitChan) // host app signals exit on its own
+	h.handleKeyboard(tea.KeyMsg{Type: tea.KeyCtrlC})
+	h.Stop()
+}
@@ -1,7 +1,12 @@
 package devtui
 
 import (
+	"io"
+	"os"
+
+	. "github.com/cdvelop/tinystring"
 	"github.com/charmbracelet/lipgloss"
+	"github.com/muesli/termenv"
 )
 
 type ColorPalette struct {
@@ -24,11 +29,17 @@ type ColorPalette struct {
 	Muted    string // #999999
 	Selected string // Derivado de Primary
 	Hover    string // Derivado de Primary
+
+	// Edit mode (opcionales): colores del valor mientras se edita un campo
+	EditBackground string // default: Secondary
+	EditForeground string // default: Foreground
 }
 
 type tuiStyle struct {
 	*ColorPalette
 
+	renderer *lipgloss.Renderer // renders every style (plain-text profile with TuiConfig.NoColor)
+
 	contentBorder    lipgloss.Border
 	headerTitleStyle lipgloss.Style
 	labelWidth       int // Ancho estándar para etiquetas
@@ -50,20 +61,52 @@ type tuiStyle struct {
 	warnStyle    lipgloss.Style
 	infoStyle    lipgloss.Style
 	normStyle    lipgloss.NoColor
+	normalStyle  *lipgloss.Style // only set when TuiConfig.MessageColors has a Msg.Normal entry
 	timeStyle    lipgloss.Style
+
+	scrollTrackStyle lipgloss.Style // scrollbar track (TuiConfig.ShowScrollbar)
+	scrollThumbStyle lipgloss.Style // scrollbar thumb
+
+	fieldKeyStyle lipgloss.Style // keys of structured key=value messages (AddFieldLogger)
+
+	unreadBadgeStyle lipgloss.Style // header badges of tabs with unread messages
+
+	multilineGuideStyle lipgloss.Style // "│ " guide on continuation lines (TuiConfig.MultilineGuides)
+
+	statusLineStyle lipgloss.Style // per-tab footer status line (SetStatus)
+
+	keyHintStyle lipgloss.Style // footer key hint line (TuiConfig.ShowKeyHints)
+
+	footerBadgeStyle lipgloss.Style // per-tab footer badge left of the scroll info (SetBadge)
+
+	headerSegmentStyle lipgloss.Style // TuiConfig.HeaderLeft / HeaderRight segments
+
+	contentFooterStyle lipgloss.Style // ContentFooter() summary of display handlers
+
+	sidebarItemStyle   lipgloss.Style // field labels of the tab sidebar (SetTabSidebar)
+	sidebarActiveStyle lipgloss.Style // active field in the tab sidebar
+
+	completedStyle lipgloss.Style // mark of completed operation lines (Complete)
+}
+
+func newTuiStyle(palette *ColorPalette, messageColors map[MessageType]string) *tuiStyle {
+	return newTuiStyleWithRenderer(lipgloss.DefaultRenderer(), palette, messageColors)
 }
 
-func newTuiStyle(palette *ColorPalette) *tuiStyle {
+// newTuiStyleWithRenderer builds the styles on renderer, whose color profile decides
+// which escape sequences are emitted (TuiConfig.NoColor uses a plain-text renderer)
+func newTuiStyleWithRenderer(renderer *lipgloss.Renderer, palette *ColorPalette, messageColors map[MessageType]string) *tuiStyle {
 	if palette == nil {
-		palette = DefaultPalette()
+		palette = defaultPaletteFor(renderer)
 	}
 
 	t := &tuiStyle{
 		ColorPalette: palette,
+		renderer:     renderer,
 		labelWidth:   18, // Definir un ancho estándar en caracteres para etiquetas
 	}
 
-	t.labelStyle = lipgloss.NewStyle().
+	t.labelStyle = t.newStyle().
 		Width(t.labelWidth).
 		Align(lipgloss.Left).
 		Padding(0, 0)
@@ -80,7 +123,7 @@ func newTuiStyle(palette *ColorPalette) *tuiStyle {
 		BottomRight: "╯",
 	}
 
-	t.headerTitleStyle = lipgloss.NewStyle().
+	t.headerTitleStyle = t.newStyle().
 		Padding(0, 1).
 		BorderForeground(lipgloss.Color(palette.Primary)).
 		Background(lipgloss.Color(palette.Primary)).
@@ -88,12 +131,12 @@ func newTuiStyle(palette *ColorPalette) *tuiStyle {
 
 	t.footerInfoStyle = t.headerTitleStyle
 
-	t.paginationStyle = lipgloss.NewStyle().
+	t.paginationStyle = t.newStyle().
 		Padding(0, 1).
 		Background(lipgloss.Color(palette.Primary)).
 		Foreground(lipgloss.Color(palette.Foreground))
 
-	t.fieldLineStyle = lipgloss.NewStyle().
+	t.fieldLineStyle = t.newStyle().
 		Padding(0, 2)
 
 	t.fieldSelectedStyle = t.fieldLineStyle
@@ -111,40 +154,211 @@ func newTuiStyle(palette *ColorPalette) *tuiStyle {
 		Foreground(lipgloss.Color(palette.Foreground))
 
 	// Estilo para los mensajes - VISUAL UPGRADE: Padding interno para mejor legibilidad
-	t.textContentStyle = lipgloss.NewStyle().
+	t.textContentStyle = t.newStyle().
 		Foreground(lipgloss.Color(palette.Foreground)).
 		PaddingLeft(1).
 		PaddingRight(1)
 
-	t.lineHeadFootStyle = lipgloss.NewStyle().
+	t.lineHeadFootStyle = t.newStyle().
 		Foreground(lipgloss.Color(palette.Primary))
 
 	// Inicializar los estilos que antes eran globales
-	t.successStyle = lipgloss.NewStyle().
+	t.successStyle = t.newStyle().
 		Bold(true).
-		Foreground(lipgloss.Color(palette.Success))
+		Foreground(lipgloss.Color(messageColor(messageColors, Msg.Success, palette.Success)))
 
-	t.errStyle = lipgloss.NewStyle().
+	t.errStyle = t.newStyle().
 		Bold(true).
-		Foreground(lipgloss.Color(palette.Error))
+		Foreground(lipgloss.Color(messageColor(messageColors, Msg.Error, palette.Error)))
 
-	t.warnStyle = lipgloss.NewStyle().
+	t.warnStyle = t.newStyle().
 		Bold(true).
-		Foreground(lipgloss.Color(palette.Warning))
+		Foreground(lipgloss.Color(messageColor(messageColors, Msg.Warning, palette.Warning)))
 
-	t.infoStyle = lipgloss.NewStyle().
+	t.infoStyle = t.newStyle().
 		Bold(true).
-		Foreground(lipgloss.Color(palette.Info))
+		Foreground(lipgloss.Color(messageColor(messageColors, Msg.Info, palette.Info)))
 
 	t.normStyle = lipgloss.NoColor{}
+	if color := messageColor(messageColors, Msg.Normal, ""); color != "" {
+		normal := t.newStyle().Foreground(lipgloss.Color(color))
+		t.normalStyle = &normal
+	}
 
-	t.timeStyle = lipgloss.NewStyle().Foreground(
+	t.timeStyle = t.newStyle().Foreground(
 		lipgloss.Color(palette.Secondary),
 	)
 
+	t.scrollTrackStyle = t.newStyle().
+		Foreground(lipgloss.Color(palette.Border))
+
+	t.scrollThumbStyle = t.newStyle().
+		Foreground(lipgloss.Color(palette.Primary))
+
+	t.fieldKeyStyle = t.newStyle().
+		Foreground(lipgloss.Color(palette.Primary))
+
+	t.unreadBadgeStyle = t.newStyle().
+		Foreground(lipgloss.Color(palette.Warning))
+
+	t.multilineGuideStyle = t.newStyle().
+		Foreground(lipgloss.Color(palette.Border))
+
+	t.statusLineStyle = t.newStyle().
+		Padding(0, 1).
+		Foreground(lipgloss.Color(palette.Muted))
+
+	t.footerBadgeStyle = t.newStyle().
+		Padding(0, 1).
+		Background(lipgloss.Color(palette.Secondary)).
+		Foreground(lipgloss.Color(palette.Foreground))
+
+	t.headerSegmentStyle = t.newStyle().
+		Padding(0, 1).
+		Background(lipgloss.Color(palette.Secondary)).
+		Foreground(lipgloss.Color(palette.Foreground))
+
+	t.contentFooterStyle = t.newStyle().
+		Padding(0, 1).
+		Bold(true).
+		Foreground(lipgloss.Color(palette.Primary))
+
+	t.sidebarItemStyle = t.newStyle().
+		Foreground(lipgloss.Color(palette.Muted))
+
+	t.sidebarActiveStyle = t.newStyle().
+		Bold(true).
+		Background(lipgloss.Color(palette.Primary)).
+		Foreground(lipgloss.Color(palette.Foreground))
+
+	t.completedStyle = t.newStyle().
+		Bold(true).
+		Foreground(lipgloss.Color(palette.Success))
+
+	t.keyHintStyle = t.newStyle().
+		Padding(0, 1).
+		Foreground(lipgloss.Color(palette.Secondary))
+
 	return t
 }
 
+// newStyle returns an empty style bound to the TUI renderer
+func (t *tuiStyle) newStyle() lipgloss.Style {
+	return t.renderer.NewStyle()
+}
+
+// newNoColorRenderer returns a renderer that never emits escape sequences (TuiConfig.NoColor)
+func newNoColorRenderer() *lipgloss.Renderer {
+	renderer := lipgloss.NewRenderer(io.Discard)
+	renderer.SetColorProfile(colorProfiles[noColorProfile])
+	return renderer
+}
+
+// colorProfiles maps TuiConfig.ForceColorProfile values to terminal color profiles
+var colorProfiles = map[string]termenv.Profile{
+	"truecolor": termenv.TrueColor,
+	"256":       termenv.ANSI256,
+	"16":        termenv.ANSI,
+	"ascii":     termenv.Ascii,
+}
+
+// noColorProfile is the profile applied by TuiConfig.NoColor / NO_COLOR
+const noColorProfile = "ascii"
+
+// profileRenderer returns a renderer of its own forced to the named color profile,
+// leaving the global lipgloss profile untouched. Unknown names return the default
+// renderer, whose profile is detected from the terminal (COLORTERM/TERM) and already
+// degrades hex colors to the nearest 256/16 color.
+func profileRenderer(name string) *lipgloss.Renderer {
+	profile, ok := colorProfiles[name]
+	if !ok {
+		return lipgloss.DefaultRenderer()
+	}
+	renderer := lipgloss.NewRenderer(os.Stdout)
+	renderer.SetColorProfile(profile)
+	return renderer
+}
+
+// editColors returns the background and foreground of a field value in edit mode,
+// falling back to Secondary/Foreground when EditBackground/EditForeground are unset
+func (t *tuiStyle) editColors() (background, foreground string) {
+	background, foreground = t.EditBackground, t.EditForeground
+	if background == "" {
+		background = t.Secondary
+	}
+	if foreground == "" {
+		foreground = t.Foreground
+	}
+	return background, foreground
+}
+
+// DefaultMessageIcons returns the suggested TuiConfig.MessageIcons glyphs:
+// ✖ error, ⚠ warning, ℹ info and ✓ success (normal messages stay without icon)
+func DefaultMessageIcons() map[MessageType]string {
+	return map[MessageType]string{
+		Msg.Error:   "✖",
+		Msg.Warning: "⚠",
+		Msg.Info:    "ℹ",
+		Msg.Success: "✓",
+	}
+}
+
+// messageColor returns the override for msgType from overrides, or fallback when unset
+func messageColor(overrides map[MessageType]string, msgType MessageType, fallback string) string {
+	if color, ok := overrides[msgType]; ok && color != "" {
+		return color
+	}
+	return fallback
+}
+
+// autoHandlerColors is the cycle used when TuiConfig.AutoColor is enabled
+var autoHandlerColors = []string{
+	"#1E40AF", // blue
+	"#047857", // green
+	"#B45309", // amber
+	"#7C3AED", // violet
+	"#BE123C", // rose
+	"#0E7490", // cyan
+	"#A21CAF", // fuchsia
+	"#4D7C0F", // lime
+}
+
+// resolveHandlerColor returns color unchanged unless it is empty and AutoColor is enabled,
+// in which case the next color of autoHandlerColors is assigned deterministically.
+func (h *DevTUI) resolveHandlerColor(color string) string {
+	if color != "" || !h.AutoColor {
+		return color
+	}
+	color = autoHandlerColors[h.autoColorIndex%len(autoHandlerColors)]
+	h.autoColorIndex++
+	return color
+}
+
+// defaultPaletteFor returns the palette used when TuiConfig.Color is nil:
+// DefaultPalette on dark terminal backgrounds, LightPalette on light ones
+func defaultPaletteFor(renderer *lipgloss.Renderer) *ColorPalette {
+	if renderer.HasDarkBackground() {
+		return DefaultPalette()
+	}
+	return LightPalette()
+}
+
+// LightPalette is the default palette for terminals with a light background
+func LightPalette() *ColorPalette {
+	return &ColorPalette{
+		Foreground: "#1A1A1A",
+		Background: "#FFFFFF",
+		Primary:    "#007D9C", // Gopher blue oscurecido para fondos claros
+		Secondary:  "#5C5C5C",
+		Success:    "#007A00",
+		Warning:    "#B35900",
+		Error:      "#CC0000",
+		Info:       "#0055CC",
+		Border:     "#BBBBBB",
+		Muted:      "#6E6E6E",
+	}
+}
+
 func DefaultPalette() *ColorPalette {
 	return &ColorPalette{
 		Foreground: "#F4F4F4",
@@ -0,0 +1,51 @@
+package devtui
+
+import (
+	"errors"
+
+	. "github.com/cdvelop/tinystring"
+)
+
+// SetActiveTab switches to the tab at index, as if the user navigated to it with
+// Tab / Shift+Tab. TuiConfig.OnTabChange is called when the active tab changes.
+// Returns an error for an out of range index.
+//
+// Example:
+//
+//	tui.SetActiveTab(2) // show the third tab
+func (h *DevTUI) SetActiveTab(index int) error {
+	if index < 0 || index >= len(h.TabSections) {
+		return errors.New(Fmt("tab index out of range: %d", index))
+	}
+	h.switchTab(index)
+	h.syncFocus()
+	h.updateViewport()
+	h.checkAndTriggerInteractiveContent()
+	return nil
+}
+
+// switchTab sets the active tab and notifies TuiConfig.OnTabChange once the index
+// actually changed. Initial tab selection assigns activeTab directly: no callback.
+func (h *DevTUI) switchTab(index int) {
+	old := h.activeTab
+	h.markActiveRead() // what arrived while it was displayed is not unread
+	h.setActiveIndex(index)
+	if old != index && h.OnTabChange != nil {
+		h.OnTabChange(old, index)
+	}
+}
+
+// setActiveIndex stores the active tab index. Called on the UI goroutine, which reads
+// activeTab freely; the lock is for readers on other goroutines (activeIndex).
+func (h *DevTUI) setActiveIndex(index int) {
+	h.activeMu.Lock()
+	h.activeTab = index
+	h.activeMu.Unlock()
+}
+
+// activeIndex returns the active tab index. Safe to call from any goroutine.
+func (h *DevTUI) activeIndex() int {
+	h.activeMu.RLock()
+	defer h.activeMu.RUnlock()
+	return h.activeTab
+}
@@ -1,12 +1,14 @@
 package devtui
 
 import (
+	"errors"
 	"fmt"
 	"strings"
 	"sync"
-	"time"
+	"sync/atomic"
 
 	. "github.com/cdvelop/tinystring"
+	"github.com/charmbracelet/lipgloss"
 )
 
 // Interface for handling tab field sectionFields
@@ -28,12 +30,24 @@ type tabContent struct {
 	handlerName    string // Formatted/padded Handler name for display
 	RawHandlerName string // Unformatted raw handler name used for matching/updating
 	handlerColor   string // NEW: Handler-specific color for message formatting
+
+	repeatCount int // consecutive identical messages collapsed into this one (coalescing)
+}
+
+// matchesOperation reports whether c belongs to operationID. The first message of a tracked
+// writer has no operationID yet: its Id becomes the writer's last operation ID.
+func (c *tabContent) matchesOperation(operationID string) bool {
+	if c.operationID != nil {
+		return *c.operationID == operationID
+	}
+	return c.Id == operationID
 }
 
 // tabSection represents a tab section in the TUI with configurable fields and content
 type tabSection struct {
 	index              int      // index of the tab
-	title              string   // eg: "BUILD", "TEST"
+	title              string   // eg: "BUILD", "TEST" - MUTABLE (SetTitle), protected by mu
+	originalTitle      string   // title given to NewTabSection, still accepted by title lookups
 	fieldHandlers      []*field // Field actions configured for the section
 	sectionDescription string   // eg: "Press 't' to compile", "Press 'r' to run tests"
 	// internal use
@@ -44,6 +58,139 @@ type tabSection struct {
 
 	// Writing handler registry for external handlers using new interfaces
 	writingHandlers []*anyHandler // CAMBIO: slice en lugar de map para thread-safety
+
+	coalesce bool // collapse consecutive identical messages into one line with a counter
+
+	sidebarFields bool // list all field labels in a left sidebar (SetTabSidebar)
+
+	messageStyles map[string]lipgloss.Style // normal message style per handler name (AddHandlerStyled), protected by mu
+
+	messageCount int // total messages received by this tab
+	readCount    int // messageCount when the tab was last displayed (header badge: unread = messageCount - readCount)
+
+	missedRedraw atomic.Bool // a UI update was skipped by TuiConfig.OverflowPolicy: redraw on the next tick
+
+	statusText string // footer status line (SetStatus), protected by mu
+	badgeText  string // footer badge left of the scroll info (SetBadge), protected by mu
+
+	severity severityFilter // messages below this severity are hidden ("e"), protected by mu
+
+	paused   bool         // output frozen on a snapshot (Space on writer-only tabs), protected by mu
+	frozen   []tabContent // messages shown while paused
+	pausedAt int          // messageCount when paused, to count pending messages
+}
+
+// SetStatus sets a status line shown below the footer while this tab is active
+// (e.g. "Server: running on :8080"). Empty text hides it. Safe from any goroutine.
+func (ts *tabSection) SetStatus(text string) {
+	ts.mu.Lock()
+	ts.statusText = text
+	ts.mu.Unlock()
+	if ts.tui != nil {
+		ts.tui.RefreshUI()
+	}
+}
+
+// clearStatus hides the status line if it still shows text
+func (ts *tabSection) clearStatus(text string) {
+	ts.mu.Lock()
+	cleared := ts.statusText == text
+	if cleared {
+		ts.statusText = ""
+	}
+	ts.mu.Unlock()
+	if cleared && ts.tui != nil {
+		ts.tui.RefreshUI()
+	}
+}
+
+// SetBadge sets a short badge shown in the footer bar, just left of the scroll
+// indicator, while this tab is active (e.g. "●Connected", "Building…").
+// Empty text hides it. Safe from any goroutine.
+func (ts *tabSection) SetBadge(text string) {
+	ts.mu.Lock()
+	ts.badgeText = text
+	ts.mu.Unlock()
+	if ts.tui != nil {
+		ts.tui.RefreshUI()
+	}
+}
+
+// badge returns the footer badge text
+func (ts *tabSection) badge() string {
+	ts.mu.RLock()
+	defer ts.mu.RUnlock()
+	return ts.badgeText
+}
+
+// RefreshDisplay requests a redraw of the display handler registered as name.
+// Safe to call from any goroutine: the request goes through the UI event loop and
+// only redraws when that handler is the visible field of the active tab.
+// Content() is still called from the UI goroutine, so handlers updated in the
+// background must guard their own state (eg: a sync.Mutex around the data).
+func (ts *tabSection) RefreshDisplay(name string) {
+	if ts.tui == nil {
+		return
+	}
+	ts.tui.sendWhenRunning(refreshDisplayMsg{tab: ts, name: name})
+}
+
+// SetTitle changes the title shown in the header and unread badges (e.g. "Build ✓" after
+// a successful build). Lookups by title (ExportPlain, CopyTabContent) also accept the
+// original title. Safe from any goroutine.
+func (ts *tabSection) SetTitle(title string) {
+	ts.mu.Lock()
+	ts.title = title
+	ts.mu.Unlock()
+	if ts.tui != nil {
+		ts.tui.RefreshUI()
+	}
+}
+
+// currentTitle returns the tab title (SetTitle)
+func (ts *tabSection) currentTitle() string {
+	ts.mu.RLock()
+	defer ts.mu.RUnlock()
+	return ts.title
+}
+
+// status returns the footer status line text
+func (ts *tabSection) status() string {
+	ts.mu.RLock()
+	defer ts.mu.RUnlock()
+	return ts.statusText
+}
+
+// countNewMessage records a new message. Producers only count it: whether it was read
+// is decided on the UI goroutine (markRead), which owns activeTab. Caller must hold ts.mu.
+func (ts *tabSection) countNewMessage() {
+	ts.messageCount++
+}
+
+// markRead marks every message received so far as read, called on the UI goroutine
+// while the tab is displayed
+func (ts *tabSection) markRead() {
+	ts.mu.Lock()
+	ts.readCount = ts.messageCount
+	ts.mu.Unlock()
+}
+
+// unread returns the number of messages received since the tab was last viewed
+// (0 for the displayed tab). Called on the UI goroutine (header rendering).
+func (ts *tabSection) unread() int {
+	if ts.tui != nil && ts.tui.activeTab == ts.index {
+		return 0
+	}
+	ts.mu.RLock()
+	defer ts.mu.RUnlock()
+	return ts.messageCount - ts.readCount
+}
+
+// markActiveRead marks the displayed tab as read. Only called on the UI goroutine.
+func (h *DevTUI) markActiveRead() {
+	if h.activeTab < len(h.TabSections) {
+		h.TabSections[h.activeTab].markRead()
+	}
 }
 
 // getWritingHandler busca un handler por nombre en el slice thread-safe
@@ -80,6 +227,40 @@ func (hw *handlerWriter) Write(p []byte) (n int, err error) {
 	return len(p), nil
 }
 
+// WriteToHandler writes msg to the logger/writer registered as name in this tab, as if it
+// was written through that writer (including its tracked operation line), for producers that
+// only know the handler name. Returns an error if no writer is registered with that name.
+//
+// Example:
+//
+//	tab.(interface{ WriteToHandler(string, ...any) error }).WriteToHandler("Builder", "compiled", 3, "files")
+func (ts *tabSection) WriteToHandler(name string, msg ...any) error {
+	handler := ts.getWritingHandler(name)
+	if handler == nil {
+		return errors.New("writer not found: " + name)
+	}
+	if len(msg) == 0 {
+		return nil
+	}
+
+	// Typed message (eg: Complete): keep its type and completion flag
+	if update, ok := msg[0].(MessageUpdate); ok && len(msg) == 1 {
+		ts.tui.sendUpdateWithHandler(update, ts, name, handler.GetLastOperationID(), handler.handlerColor)
+		if update.Type == Msg.Error && ts.tui.Logger != nil {
+			ts.tui.Logger(update.Content)
+		}
+		return nil
+	}
+
+	message, msgType := Translate(msg...).StringType()
+	ts.tui.sendMessageWithHandler(message, msgType, ts, name, handler.GetLastOperationID(), handler.handlerColor)
+
+	if msgType == Msg.Error && ts.tui.Logger != nil {
+		ts.tui.Logger(message)
+	}
+	return nil
+}
+
 // registerLoggerFunc creates a logger function that handles variadic arguments
 func (ts *tabSection) registerLoggerFunc(handler HandlerLogger, color string) func(message ...any) {
 	ts.mu.Lock()
@@ -103,6 +284,22 @@ func (ts *tabSection) registerLoggerFunc(handler HandlerLogger, color string) fu
 			return
 		}
 
+		var operationID string
+		var handlerColor string
+		if handler := ts.getWritingHandler(anyH.Name()); handler != nil {
+			operationID = handler.GetLastOperationID()
+			handlerColor = handler.handlerColor // NEW: Get handler color
+		}
+
+		// Typed message (eg: Complete): keep its type and completion flag
+		if update, ok := message[0].(MessageUpdate); ok && len(message) == 1 {
+			ts.tui.sendUpdateWithHandler(update, ts, anyH.Name(), operationID, handlerColor)
+			if update.Type == Msg.Error {
+				ts.tui.Logger(update.Content)
+			}
+			return
+		}
+
 		// Format the message similar to fmt.Sprint
 		var msg string
 		if len(message) == 1 {
@@ -118,13 +315,6 @@ func (ts *tabSection) registerLoggerFunc(handler HandlerLogger, color string) fu
 			}
 		}
 
-		var operationID string
-		var handlerColor string
-		if handler := ts.getWritingHandler(anyH.Name()); handler != nil {
-			operationID = handler.GetLastOperationID()
-			handlerColor = handler.handlerColor // NEW: Get handler color
-		}
-
 		messageStr, msgType := Translate(msg).StringType()
 		ts.tui.sendMessageWithHandler(messageStr, msgType, ts, anyH.Name(), operationID, handlerColor)
 
@@ -144,35 +334,41 @@ func (t *tabSection) addNewContent(msgType MessageType, content string) {
 	t.mu.Lock()
 	defer t.mu.Unlock()
 	t.tabContents = append(t.tabContents, t.tui.createTabContent(content, msgType, t, "", "", ""))
+	t.countNewMessage()
 }
 
 // NEW: updateOrAddContentWithHandler updates existing content by operationID or adds new if not found
 // Returns true if content was updated, false if new content was added
 func (t *tabSection) updateOrAddContentWithHandler(msgType MessageType, content string, handlerName string, operationID string, handlerColor string) (updated bool, newContent tabContent) {
+	return t.updateOrAddContent(MessageUpdate{Content: content, Type: msgType}, handlerName, operationID, handlerColor)
+}
+
+// updateOrAddContent is updateOrAddContentWithHandler for a MessageUpdate: a Final update
+// completes (freezes) the operation line
+func (t *tabSection) updateOrAddContent(msg MessageUpdate, handlerName string, operationID string, handlerColor string) (updated bool, newContent tabContent) {
 	t.mu.Lock()
 	defer t.mu.Unlock()
 
+	content, msgType, complete := msg.Content, msg.Type, msg.Final
+
 	// If operationID is provided, try to find and update existing content
 	if operationID != "" {
 		for i := range t.tabContents {
-			// Match by both operationID and handlerName to ensure each handler updates its own message
-			if t.tabContents[i].operationID != nil &&
-				*t.tabContents[i].operationID == operationID &&
-				t.tabContents[i].RawHandlerName == handlerName {
+			// Match by both operationID and handlerName to ensure each handler updates its own message.
+			// Completed operations are frozen (Complete)
+			if t.tabContents[i].matchesOperation(operationID) &&
+				t.tabContents[i].RawHandlerName == handlerName &&
+				!t.tabContents[i].isComplete {
 				// Update existing content
 				t.tabContents[i].Content = content
 				t.tabContents[i].Type = msgType
-				// Actualizar timestamp usando GetNewID directamente
-				if t.tui.id != nil {
-					t.tabContents[i].Timestamp = t.tui.id.GetNewID()
-				} else {
+				t.tabContents[i].isComplete = complete
+				// Actualizar timestamp (unixid o reloj TuiConfig.Now)
+				if t.tui.id == nil && t.tui.Logger != nil {
 					// Log the issue before using fallback
-					if t.tui.Logger != nil {
-						t.tui.Logger("Warning: unixid not initialized, using fallback timestamp for content update:", content)
-					}
-					// Graceful fallback when unixid initialization failed
-					t.tabContents[i].Timestamp = time.Now().Format("15:04:05")
+					t.tui.Logger("Warning: unixid not initialized, using fallback timestamp for content update:", content)
 				}
+				t.tabContents[i].Timestamp = t.tui.messageTimestamp()
 				// Move updated content to end
 				updatedContent := t.tabContents[i]
 				t.tabContents = append(t.tabContents[:i], t.tabContents[i+1:]...)
@@ -182,9 +378,22 @@ func (t *tabSection) updateOrAddContentWithHandler(msgType MessageType, content
 		}
 	}
 
+	// Coalescing: repeated identical message from the same handler updates the last line's counter
+	if t.coalesce && operationID == "" && len(t.tabContents) > 0 {
+		last := &t.tabContents[len(t.tabContents)-1]
+		if last.Content == content && last.Type == msgType && last.RawHandlerName == handlerName {
+			last.repeatCount = max(last.repeatCount, 1) + 1
+			last.Timestamp = t.tui.messageTimestamp()
+			t.countNewMessage()
+			return true, *last
+		}
+	}
+
 	// If not found or no operationID, add new content
 	newContent = t.tui.createTabContent(content, msgType, t, handlerName, operationID, handlerColor)
+	newContent.isComplete = complete
 	t.tabContents = append(t.tabContents, newContent)
+	t.countNewMessage()
 	return false, newContent
 }
 
@@ -197,8 +406,19 @@ func (t *tabSection) updateOrAddContentWithHandler(msgType MessageType, content
 func (t *DevTUI) NewTabSection(title, description string) any {
 	tab := &tabSection{
 		title:              title,
+		originalTitle:      title,
 		sectionDescription: description,
 		tui:                t,
+		coalesce:           t.CoalesceMessages,
+	}
+
+	// Enforce TuiConfig.MaxTabs: return a detached tab so AddHandler/AddLogger stay harmless no-ops
+	if t.MaxTabs > 0 && len(t.TabSections) >= t.MaxTabs {
+		if t.Logger != nil {
+			t.Logger(Fmt("Error: NewTabSection %q ignored, MaxTabs limit of %d reached", title, t.MaxTabs))
+		}
+		tab.index = -1
+		return tab
 	}
 
 	// Automatically add to TabSections and initialize
@@ -208,6 +428,37 @@ func (t *DevTUI) NewTabSection(title, description string) any {
 	return tab
 }
 
+// SetTabCoalescing enables or disables collapsing of consecutive identical messages
+// for a single tab, overriding TuiConfig.CoalesceMessages.
+//
+// Example:
+//
+//	logs := tui.NewTabSection("LOGS", "Noisy output")
+//	tui.SetTabCoalescing(logs, true) // "retry" x100 -> "retry (x100)"
+func (t *DevTUI) SetTabCoalescing(tabSection any, enabled bool) {
+	ts := t.validateTabSection(tabSection, "SetTabCoalescing")
+	ts.mu.Lock()
+	ts.coalesce = enabled
+	ts.mu.Unlock()
+}
+
+// activeField returns the selected field, clamping an out-of-range indexActiveEditField
+// to 0 (reported through Logger with TuiConfig.WarnFieldIndexClamp). Nil when the tab
+// has no fields. Every field-access path goes through here instead of indexing directly.
+func (ts *tabSection) activeField() *field {
+	if len(ts.fieldHandlers) == 0 {
+		return nil
+	}
+	if ts.indexActiveEditField < 0 || ts.indexActiveEditField >= len(ts.fieldHandlers) {
+		if ts.tui != nil && ts.tui.WarnFieldIndexClamp && ts.tui.Logger != nil {
+			ts.tui.Logger(fmt.Sprintf("Field index %d out of range in tab %q (%d fields), reset to 0",
+				ts.indexActiveEditField, ts.currentTitle(), len(ts.fieldHandlers)))
+		}
+		ts.indexActiveEditField = 0
+	}
+	return ts.fieldHandlers[ts.indexActiveEditField]
+}
+
 // setActiveEditField sets the active edit field index
 func (ts *tabSection) setActiveEditField(idx int) {
 	ts.indexActiveEditField = idx
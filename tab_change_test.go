@@ -0,0 +1,52 @@
+package devtui
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestOnTabChangeReportsEachSwitch(t *testing.T) {
+	var changes [][2]int
+	h := NewTUI(&TuiConfig{
+		AppName:     "Tabs",
+		ExitChan:    make(chan bool),
+		Logger:      func(...any) {},
+		OnTabChange: func(oldIndex, newIndex int) { changes = append(changes, [2]int{oldIndex, newIndex}) },
+	})
+	h.SetTestMode(true)
+	h.viewport.Width = 80
+	h.NewTabSection("Build", "first")
+	h.NewTabSection("Deploy", "second")
+	h.activeTab = 1 // initial tab, as Start() selects it
+
+	if len(changes) != 0 {
+		t.Fatalf("Expected no callback for the initial tab, got %v", changes)
+	}
+
+	h.handleKeyboard(tea.KeyMsg{Type: tea.KeyTab})      // 1 -> 2
+	h.handleKeyboard(tea.KeyMsg{Type: tea.KeyTab})      // 2 -> 0 (SHORTCUTS)
+	h.handleKeyboard(tea.KeyMsg{Type: tea.KeyShiftTab}) // 0 -> 2
+	if err := h.SetActiveTab(1); err != nil {           // 2 -> 1
+		t.Fatal(err)
+	}
+	if err := h.SetActiveTab(1); err != nil { // already active: no callback
+		t.Fatal(err)
+	}
+	if err := h.SetActiveTab(5); err == nil {
+		t.Error("Expected an error for an out of range tab")
+	}
+
+	want := [][2]int{{1, 2}, {2, 0}, {0, 2}, {2, 1}}
+	if len(changes) != len(want) {
+		t.Fatalf("Expected %d callbacks %v, got %v", len(want), want, changes)
+	}
+	for i := range want {
+		if changes[i] != want[i] {
+			t.Errorf("Change %d: expected %v, got %v", i, want[i], changes[i])
+		}
+	}
+	if h.activeTab != 1 {
+		t.Errorf("Expected tab 1 active, got %d", h.activeTab)
+	}
+}
@@ -0,0 +1,59 @@
+package devtui
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/charmbracelet/x/ansi"
+)
+
+// titleBuildHandler is an execution handler reflecting its result in the tab title
+type titleBuildHandler struct {
+	tab interface{ SetTitle(string) }
+}
+
+func (h *titleBuildHandler) Name() string  { return "Builder" }
+func (h *titleBuildHandler) Label() string { return "Build" }
+func (h *titleBuildHandler) Execute(progress chan<- string) {
+	h.tab.SetTitle("Build ✓")
+}
+
+func TestSetTitleFromHandlerUpdatesHeader(t *testing.T) {
+	h := DefaultTUIForTest()
+	h.viewport.Width = 80
+	h.viewport.Height = 10
+	tab := h.NewTabSection("Build", "Title test")
+	ts := tab.(*tabSection)
+	h.AddHandler(&titleBuildHandler{tab: ts}, 0, "", tab)
+	h.activeTab = ts.index
+
+	if header := ansi.Strip(h.headerView()); !strings.Contains(header, "/Build ") {
+		t.Fatalf("Expected initial title in header, got %q", header)
+	}
+
+	ts.fieldHandlers[0].handleEnter()
+
+	if header := ansi.Strip(h.headerView()); !strings.Contains(header, "/Build ✓") {
+		t.Errorf("Expected updated title in header, got %q", header)
+	}
+}
+
+func TestSetTitleKeepsTitleLookups(t *testing.T) {
+	h := DefaultTUIForTest()
+	tab := h.NewTabSection("Deploy", "Title test")
+	h.AddLogger("Deployer", false, "", tab)("deployed v1")
+	ts := tab.(*tabSection)
+
+	ts.SetTitle("Deploy ✓")
+
+	for _, title := range []string{"Deploy ✓", "Deploy"} {
+		text, err := h.ExportPlain(title)
+		if err != nil {
+			t.Errorf("ExportPlain(%q) returned error: %v", title, err)
+			continue
+		}
+		if !strings.Contains(text, "deployed v1") {
+			t.Errorf("ExportPlain(%q) returned unexpected content %q", title, text)
+		}
+	}
+}
@@ -0,0 +1,76 @@
+package devtui
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func setupTabTraversalTest(t *testing.T) (*DevTUI, *TestEditableHandler) {
+	h := NewTUI(&TuiConfig{ExitChan: make(chan bool), TabTraversal: true})
+	h.SetTestMode(true)
+	h.viewport.Width = 80
+
+	first := h.NewTabSection("Form", "First form")
+	h.AddHandler(NewTestEditableHandler("Name", "alice"), 0, "", first)
+	last := NewTestEditableHandler("Email", "a@b.c")
+	h.AddHandler(last, 0, "", first)
+
+	second := h.NewTabSection("More", "Second form")
+	h.AddHandler(NewTestEditableHandler("City", "Paris"), 0, "", second)
+
+	return h, last
+}
+
+func TestTabTraversalAtLastFieldMovesToNextTab(t *testing.T) {
+	h, lastHandler := setupTabTraversalTest(t)
+
+	// Edit the last field of the first test tab
+	h.activeTab = GetFirstTestTabIndex()
+	h.TabSections[h.activeTab].indexActiveEditField = 1
+	h.handleKeyboard(tea.KeyMsg{Type: tea.KeyEnter})
+	h.handleKeyboard(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("!")})
+
+	h.handleKeyboard(tea.KeyMsg{Type: tea.KeyTab})
+
+	if h.activeTab != GetSecondTestTabIndex() {
+		t.Fatalf("Expected focus on tab %d, got %d", GetSecondTestTabIndex(), h.activeTab)
+	}
+	if idx := h.TabSections[h.activeTab].indexActiveEditField; idx != 0 {
+		t.Errorf("Expected first field of next tab, got index %d", idx)
+	}
+	if !h.editModeActivated {
+		t.Error("Expected next editable field to open in edit mode")
+	}
+	if got := h.TabSections[h.activeTab].fieldHandlers[0].tempEditValue; got != "Paris" {
+		t.Errorf("Expected next field edit value 'Paris', got '%s'", got)
+	}
+	if lastHandler.Value() != "a@b.c!" {
+		t.Errorf("Expected previous field saved as 'a@b.c!', got '%s'", lastHandler.Value())
+	}
+}
+
+func TestTabTraversalMovesWithinTab(t *testing.T) {
+	h, _ := setupTabTraversalTest(t)
+
+	h.activeTab = GetFirstTestTabIndex()
+	h.TabSections[h.activeTab].indexActiveEditField = 0
+	h.handleKeyboard(tea.KeyMsg{Type: tea.KeyEnter})
+	h.handleKeyboard(tea.KeyMsg{Type: tea.KeyTab})
+
+	if h.activeTab != GetFirstTestTabIndex() || h.TabSections[h.activeTab].indexActiveEditField != 1 {
+		t.Errorf("Expected second field of same tab, got tab %d field %d",
+			h.activeTab, h.TabSections[h.activeTab].indexActiveEditField)
+	}
+}
+
+func TestTabInEditModeWithoutTraversalIsIgnored(t *testing.T) {
+	h, field := setupTestWithEditableField(t)
+	tab := h.activeTab
+
+	h.handleKeyboard(tea.KeyMsg{Type: tea.KeyTab})
+
+	if h.activeTab != tab || !h.editModeActivated || field.tempEditValue != "" {
+		t.Error("Tab in edit mode should do nothing when TabTraversal is disabled")
+	}
+}
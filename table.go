@@ -0,0 +1,67 @@
+package devtui
+
+import (
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// tableColumnGap separates table columns
+const tableColumnGap = "  "
+
+// renderTable aligns headers and rows into columns sized to their widest cell.
+// Rows with fewer cells are padded; every line is truncated to the content width.
+func (h *DevTUI) renderTable(headers []string, rows [][]string) string {
+	columns := len(headers)
+	for _, row := range rows {
+		columns = max(columns, len(row))
+	}
+	if columns == 0 {
+		return ""
+	}
+
+	widths := make([]int, columns)
+	measure := func(cells []string) {
+		for i, cell := range cells {
+			widths[i] = max(widths[i], lipgloss.Width(cell))
+		}
+	}
+	measure(headers)
+	for _, row := range rows {
+		measure(row)
+	}
+
+	formatRow := func(cells []string) string {
+		padded := make([]string, columns)
+		for i := range padded {
+			cell := ""
+			if i < len(cells) {
+				cell = cells[i]
+			}
+			padded[i] = cell + strings.Repeat(" ", widths[i]-lipgloss.Width(cell))
+		}
+		return strings.TrimRight(strings.Join(padded, tableColumnGap), " ")
+	}
+
+	total := 0
+	for _, w := range widths {
+		total += w
+	}
+	total += len(tableColumnGap) * (columns - 1)
+
+	var lines []string
+	if len(headers) > 0 {
+		lines = append(lines, formatRow(headers), strings.Repeat("─", total))
+	}
+	for _, row := range rows {
+		lines = append(lines, formatRow(row))
+	}
+
+	// Truncate to the viewport content width, unless it can be scrolled horizontally
+	if width := h.viewport.Width - h.textContentStyle.GetHorizontalFrameSize(); width > 0 && h.HorizontalScrollStep <= 0 {
+		for i, line := range lines {
+			lines[i] = h.truncate(line, width)
+		}
+	}
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,141 @@
+package devtui
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+type testTableHandler struct {
+	headers []string
+	rows    [][]string
+}
+
+func (h *testTableHandler) Name() string      { return "Services" }
+func (h *testTableHandler) Headers() []string { return h.headers }
+func (h *testTableHandler) Rows() [][]string  { return h.rows }
+
+func TestRenderTableAlignsColumns(t *testing.T) {
+	h := DefaultTUIForTest()
+	h.viewport.Width = 80
+
+	out := h.renderTable(
+		[]string{"Service", "Status", "Uptime"},
+		[][]string{
+			{"api", "running", "2h"},
+			{"database", "stopped"}, // shorter row is padded
+		},
+	)
+	lines := strings.Split(out, "\n")
+	if len(lines) != 4 {
+		t.Fatalf("Expected header, separator and 2 rows, got %d lines:\n%s", len(lines), out)
+	}
+
+	statusCol := strings.Index(lines[0], "Status")
+	if idx := strings.Index(lines[2], "running"); idx != statusCol {
+		t.Errorf("Expected 'running' at column %d, got %d in %q", statusCol, idx, lines[2])
+	}
+	if idx := strings.Index(lines[3], "stopped"); idx != statusCol {
+		t.Errorf("Expected 'stopped' at column %d, got %d in %q", statusCol, idx, lines[3])
+	}
+}
+
+func TestRenderTableTruncatesToViewport(t *testing.T) {
+	h := DefaultTUIForTest()
+	h.viewport.Width = 20
+
+	out := h.renderTable([]string{"Name", "Description"}, [][]string{{"x", strings.Repeat("long ", 20)}})
+	for _, line := range strings.Split(out, "\n") {
+		if w := lipgloss.Width(line); w > 18 {
+			t.Errorf("Line width %d exceeds content width 18: %q", w, line)
+		}
+	}
+}
+
+func TestTableHandlerRegisteredAsReadOnly(t *testing.T) {
+	h := DefaultTUIForTest()
+	h.viewport.Width = 80
+	tab := h.NewTabSection("Status", "Table test")
+	handler := &testTableHandler{headers: []string{"Service", "Status"}, rows: [][]string{{"api", "up"}}}
+	h.AddHandler(handler, 0, "", tab)
+
+	h.activeTab = GetFirstTestTabIndex()
+	field := tab.(*tabSection).fieldHandlers[0]
+	if !field.isTableHandler() || !field.isDisplayOnly() || field.editable() {
+		t.Fatal("Table handler should be a read-only table field")
+	}
+
+	if content := h.ContentView(); !strings.Contains(content, "Service") || !strings.Contains(content, "api") {
+		t.Errorf("Expected table in content view, got:\n%s", content)
+	}
+
+	// Rows are re-read on each render
+	handler.rows = [][]string{{"worker", "down"}}
+	if content := h.ContentView(); !strings.Contains(content, "worker") {
+		t.Errorf("Expected refreshed rows in content view, got:\n%s", content)
+	}
+
+	if footer := h.footerView(); !strings.Contains(footer, "Services") {
+		t.Errorf("Expected handler name in footer, got:\n%s", footer)
+	}
+}
+
+// headerlessTableHandler implements HandlerTable without the optional Headers()
+type headerlessTableHandler struct{}
+
+func (h *headerlessTableHandler) Name() string { return "Ports" }
+func (h *headerlessTableHandler) Rows() [][]string {
+	return [][]string{{"api", "8080"}, {"database-primary", "5432", "tcp"}}
+}
+
+func TestRenderTableRaggedWideRows(t *testing.T) {
+	h := DefaultTUIForTest()
+	h.viewport.Width = 80
+
+	out := h.renderTable(
+		[]string{"Name", "Region", "Notes"},
+		[][]string{
+			{"東京", "ap"}, // wide runes, short row
+			{"lisbon", "eu-west", "primary"},
+			{"x"},
+		},
+	)
+	lines := strings.Split(out, "\n")
+	if len(lines) != 5 {
+		t.Fatalf("Expected header, underline and 3 rows, got %d lines:\n%s", len(lines), out)
+	}
+
+	if underline := strings.Trim(lines[1], "─"); underline != "" {
+		t.Errorf("Expected header underline of ─ only, got %q", lines[1])
+	}
+	if uw, tw := lipgloss.Width(lines[1]), lipgloss.Width(lines[3]); uw != tw {
+		t.Errorf("Expected underline width %d to span the full table width %d", uw, tw)
+	}
+
+	// Columns start at the same display column in every row (width aware, not byte aware)
+	regionCol := lipgloss.Width(lines[0][:strings.Index(lines[0], "Region")])
+	for _, row := range []struct {
+		line, cell string
+	}{{lines[2], "ap"}, {lines[3], "eu-west"}} {
+		if col := lipgloss.Width(row.line[:strings.Index(row.line, row.cell)]); col != regionCol {
+			t.Errorf("Expected %q at display column %d, got %d in %q", row.cell, regionCol, col, row.line)
+		}
+	}
+}
+
+func TestTableHandlerWithoutHeaders(t *testing.T) {
+	h := DefaultTUIForTest()
+	h.viewport.Width = 80
+	tab := h.NewTabSection("Ports", "Headerless table")
+	h.AddHandler(&headerlessTableHandler{}, 0, "", tab)
+	h.activeTab = GetFirstTestTabIndex()
+
+	content := h.ContentView()
+	if strings.Contains(content, "─") {
+		t.Errorf("Expected no header underline without Headers(), got:\n%s", content)
+	}
+	if !strings.Contains(content, "database-primary  5432  tcp") || !strings.Contains(content, "api               8080") {
+		t.Errorf("Expected aligned headerless rows, got:\n%s", content)
+	}
+}
@@ -0,0 +1,115 @@
+package devtui
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	. "github.com/cdvelop/tinystring"
+)
+
+func TestTimestampsAreRealAndNonDecreasing(t *testing.T) {
+	h := DefaultTUIForTest()
+	tab := h.NewTabSection("Logs", "Timestamp test")
+	log := h.AddLogger("Clock", false, "", tab)
+
+	for i := 0; i < 10; i++ {
+		log(Fmt("message %d", i))
+	}
+
+	ts := tab.(*tabSection)
+	ts.mu.RLock()
+	contents := append([]tabContent(nil), ts.tabContents...)
+	ts.mu.RUnlock()
+
+	if len(contents) != 10 {
+		t.Fatalf("Expected 10 messages, got %d", len(contents))
+	}
+
+	now := time.Now()
+	var previousNano int64
+	previousClock := ""
+	for i, c := range contents {
+		nano, ok := parseTimestampNano(c.Timestamp)
+		if !ok {
+			t.Fatalf("Message %d: timestamp %q is not a unix nano value", i, c.Timestamp)
+		}
+		if nano < previousNano {
+			t.Errorf("Message %d: timestamp %d is before previous %d", i, nano, previousNano)
+		}
+		if d := now.Sub(time.Unix(0, nano)); d < 0 || d > time.Minute {
+			t.Errorf("Message %d: timestamp %v is not close to now (%v)", i, time.Unix(0, nano), now)
+		}
+		previousNano = nano
+
+		clock := strings.TrimSpace(h.generateTimestamp(c.Timestamp))
+		if clock == "" || clock == "--:--:--" {
+			t.Fatalf("Message %d: expected rendered clock, got %q", i, clock)
+		}
+		if clock < previousClock {
+			t.Errorf("Message %d: displayed time %s is before previous %s", i, clock, previousClock)
+		}
+		previousClock = clock
+	}
+}
+
+func TestGenerateTimestampFormats(t *testing.T) {
+	h := DefaultTUIForTest()
+	nano := time.Date(2024, 1, 15, 8, 30, 45, 0, time.Local).UnixNano()
+
+	cases := map[string]string{
+		Fmt("%d", nano):    "08:30:45",
+		Fmt("%d.42", nano): "08:30:45", // unixid with session number
+		"12:34:56":         "12:34:56", // already formatted fallback
+		"":                 "--:--:--",
+		"not-a-timestamp":  "--:--:--",
+	}
+	for input, expected := range cases {
+		if got := h.generateTimestamp(input); !strings.Contains(got, expected) {
+			t.Errorf("generateTimestamp(%q) = %q, expected %q", input, got, expected)
+		}
+	}
+}
+
+func TestTimestampFormatWithMilliseconds(t *testing.T) {
+	h := NewTUI(&TuiConfig{ExitChan: make(chan bool), TimestampFormat: "15:04:05.000"})
+	h.SetTestMode(true)
+
+	nano := time.Date(2024, 1, 15, 8, 30, 45, 123_000_000, time.Local).UnixNano()
+	if got := h.generateTimestamp(Fmt("%d", nano)); !strings.Contains(got, "08:30:45.123") {
+		t.Errorf("Expected millisecond timestamp '08:30:45.123', got %q", got)
+	}
+
+	if got := h.generateTimestamp(""); !strings.Contains(got, "--:--:--.---") {
+		t.Errorf("Expected fallback '--:--:--.---', got %q", got)
+	}
+}
+
+func TestTimestampFormatDefault(t *testing.T) {
+	h := DefaultTUIForTest()
+	if h.TimestampFormat != defaultTimestampFormat {
+		t.Errorf("Expected default format %q, got %q", defaultTimestampFormat, h.TimestampFormat)
+	}
+}
+
+func TestRelativeTimestampMode(t *testing.T) {
+	h := NewTUI(&TuiConfig{ExitChan: make(chan bool), TimestampMode: TimestampRelative})
+	h.SetTestMode(true)
+
+	now := time.Date(2024, 1, 15, 8, 30, 0, 0, time.Local)
+	h.now = func() time.Time { return now }
+
+	cases := map[time.Duration]string{
+		5 * time.Second:  "5s",
+		90 * time.Second: "1m",
+		3 * time.Hour:    "3h",
+		50 * time.Hour:   "2d",
+	}
+	for age, expected := range cases {
+		stamp := Fmt("%d", now.Add(-age).UnixNano())
+		got := strings.TrimSpace(h.generateTimestamp(stamp))
+		if got != expected {
+			t.Errorf("Age %v: expected %q, got %q", age, expected, got)
+		}
+	}
+}
@@ -0,0 +1,49 @@
+package devtui
+
+import (
+	"strings"
+	"testing"
+
+	. "github.com/cdvelop/tinystring"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestTimestampsHiddenByConfig(t *testing.T) {
+	h := NewTUI(&TuiConfig{ExitChan: make(chan bool), HideTimestamps: true})
+	h.SetTestMode(true)
+	tab := h.NewTabSection("Test", "Timestamps")
+
+	tc := h.createTabContent("Build done", Msg.Success, tab.(*tabSection), "Builder", "", "")
+	tc.Timestamp = "12:34:56"
+	clock := h.generateTimestamp(tc.Timestamp)
+	formatted := h.formatMessage(tc)
+
+	if strings.Contains(formatted, clock) {
+		t.Errorf("Expected no timestamp %q in %q", clock, formatted)
+	}
+	if !strings.Contains(formatted, "Builder") || !strings.Contains(formatted, "Build done") {
+		t.Errorf("Expected handler prefix and content in %q", formatted)
+	}
+}
+
+func TestTimestampsToggleWithCtrlT(t *testing.T) {
+	h := DefaultTUIForTest()
+	tab := h.NewTabSection("Test", "Timestamps")
+	tc := h.createTabContent("Build done", Msg.Normal, tab.(*tabSection), "Builder", "", "")
+	tc.Timestamp = "12:34:56"
+	clock := h.generateTimestamp(tc.Timestamp)
+
+	if !strings.Contains(h.formatMessage(tc), clock) {
+		t.Fatal("Timestamps should be shown by default")
+	}
+
+	h.handleKeyboard(tea.KeyMsg{Type: tea.KeyCtrlT})
+	if strings.Contains(h.formatMessage(tc), clock) {
+		t.Error("Timestamps should be hidden after Ctrl+T")
+	}
+
+	h.handleKeyboard(tea.KeyMsg{Type: tea.KeyCtrlT})
+	if !strings.Contains(h.formatMessage(tc), clock) {
+		t.Error("Timestamps should be shown again after second Ctrl+T")
+	}
+}
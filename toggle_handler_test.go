@@ -0,0 +1,79 @@
+package devtui
+
+import (
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// verboseToggle is an on/off setting recording every value it receives
+type verboseToggle struct {
+	on      bool
+	changes []bool
+}
+
+func (v *verboseToggle) Name() string  { return "Verbose" }
+func (v *verboseToggle) Label() string { return "Verbose Output" }
+func (v *verboseToggle) Current() bool { return v.on }
+func (v *verboseToggle) Change(newValue bool, progress func(...any)) {
+	v.on = newValue
+	v.changes = append(v.changes, newValue)
+	progress("verbose", newValue)
+}
+
+func TestToggleHandlerSpaceAndEnterFlip(t *testing.T) {
+	h := DefaultTUIForTest()
+	h.viewport.Width = 80
+	h.viewport.Height = 10
+	tab := h.NewTabSection("Config", "Toggle test")
+	toggle := &verboseToggle{}
+	h.AddHandler(toggle, 0, "", tab)
+	ts := tab.(*tabSection)
+	h.activeTab = ts.index
+	f := ts.fieldHandlers[0]
+
+	if !f.isToggleHandler() {
+		t.Fatalf("Expected HandlerToggle to be registered as a toggle field")
+	}
+	if f.editable() {
+		t.Errorf("Expected toggle fields not to open edit mode")
+	}
+	if got := f.Value(); got != "[ ]" {
+		t.Errorf("Expected unchecked mark, got %q", got)
+	}
+
+	h.handleKeyboard(tea.KeyMsg{Type: tea.KeySpace})
+	if !toggle.on || f.Value() != "[x]" {
+		t.Fatalf("Expected Space to turn the toggle on, got on=%v value=%q", toggle.on, f.Value())
+	}
+	if h.editModeActivated {
+		t.Errorf("Expected Space to commit immediately without entering edit mode")
+	}
+	if footer := h.renderFooterInput(); !strings.Contains(footer, "[x]") {
+		t.Errorf("Expected footer to show [x], got %q", footer)
+	}
+
+	h.handleKeyboard(tea.KeyMsg{Type: tea.KeyEnter})
+	if toggle.on {
+		t.Errorf("Expected Enter to turn the toggle off")
+	}
+	if len(toggle.changes) != 2 || toggle.changes[0] != true || toggle.changes[1] != false {
+		t.Errorf("Expected Change(true) then Change(false), got %v", toggle.changes)
+	}
+}
+
+func TestSpaceIgnoredOnNonToggleFields(t *testing.T) {
+	h := DefaultTUIForTest()
+	h.viewport.Width = 80
+	tab := h.NewTabSection("Config", "Toggle test")
+	handler := NewTestEditableHandler("Host", "localhost")
+	h.AddHandler(handler, 0, "", tab)
+	ts := tab.(*tabSection)
+	h.activeTab = ts.index
+
+	h.handleKeyboard(tea.KeyMsg{Type: tea.KeySpace})
+	if h.editModeActivated || handler.Value() != "localhost" {
+		t.Errorf("Expected Space to do nothing on an edit field in normal mode")
+	}
+}
@@ -0,0 +1,24 @@
+package devtui
+
+import (
+	"github.com/charmbracelet/x/ansi"
+)
+
+// defaultEllipsis is used when TuiConfig.EllipsisString is empty
+const defaultEllipsis = "…"
+
+// truncate shortens s to at most width terminal cells, ending with TuiConfig.EllipsisString
+// when text was cut. ANSI sequences and wide runes are measured correctly.
+func (h *DevTUI) truncate(s string, width int) string {
+	if width <= 0 {
+		return ""
+	}
+	if ansi.StringWidth(s) <= width {
+		return s
+	}
+	tail := h.EllipsisString
+	if ansi.StringWidth(tail) >= width {
+		tail = ""
+	}
+	return ansi.Truncate(s, width, tail)
+}
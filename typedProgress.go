@@ -0,0 +1,67 @@
+package devtui
+
+import . "github.com/cdvelop/tinystring"
+
+// MessageUpdate is a progress message with an explicit type, rendered as Type instead of
+// detecting it from Content (a success message mentioning "error" stays a success).
+type MessageUpdate struct {
+	Content string
+	Type    MessageType
+	Status  bool // short footer status instead of a log line (see Status)
+	Final   bool // last message of a tracked operation: its line is frozen (see Complete)
+}
+
+// Optional typed progress variants, preferred over Change/Execute when implemented:
+//
+//	ChangeTyped(newValue string, progress chan<- MessageUpdate) // HandlerEdit / HandlerInteractive
+//	ExecuteTyped(progress chan<- MessageUpdate)                 // HandlerExecution
+//
+// Example:
+//
+//	func (l *Linter) ExecuteTyped(progress chan<- devtui.MessageUpdate) {
+//		for _, issue := range l.run() {
+//			progress <- devtui.MessageUpdate{Content: issue.Text, Type: issue.Severity}
+//		}
+//	}
+
+// detectUpdate returns msgs as a MessageUpdate typed from its text
+func detectUpdate(msgs ...any) MessageUpdate {
+	content, msgType := Translate(msgs...).StringType()
+	return MessageUpdate{Content: content, Type: msgType}
+}
+
+// detectTypedProgress wires the optional ChangeTyped/ExecuteTyped methods of h
+func (a *anyHandler) detectTypedProgress(h any) {
+	if changer, ok := h.(interface {
+		ChangeTyped(newValue string, progress chan<- MessageUpdate)
+	}); ok {
+		a.changeTypedFunc = changer.ChangeTyped
+	}
+	if executor, ok := h.(interface {
+		ExecuteTyped(progress chan<- MessageUpdate)
+	}); ok {
+		a.changeTypedFunc = func(_ string, progress chan<- MessageUpdate) { executor.ExecuteTyped(progress) }
+	}
+}
+
+// changeTyped runs the handler for the field execution paths. ChangeTyped/ExecuteTyped
+// updates are delivered as sent; plain string progress gets its type detected per message.
+func (a *anyHandler) changeTyped(newValue string, progress chan<- MessageUpdate) {
+	if a.changeTypedFunc != nil {
+		a.changeTypedFunc(newValue, progress)
+		return
+	}
+	plain := make(chan string, 10)
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for msg := range plain {
+			progress <- detectUpdate(msg)
+		}
+	}()
+	defer func() { // also on panic, so the forwarder never outlives the handler
+		close(plain)
+		<-done
+	}()
+	a.Change(newValue, plain)
+}
@@ -0,0 +1,115 @@
+package devtui
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	. "github.com/cdvelop/tinystring"
+)
+
+// typedLinter reports issues with explicit severities through ExecuteTyped
+type typedLinter struct{}
+
+func (l *typedLinter) Name() string                   { return "Linter" }
+func (l *typedLinter) Label() string                  { return "Lint" }
+func (l *typedLinter) Execute(progress chan<- string) { progress <- "untyped fallback" }
+func (l *typedLinter) ExecuteTyped(progress chan<- MessageUpdate) {
+	progress <- MessageUpdate{Content: "0 error patterns found", Type: Msg.Success}
+	progress <- MessageUpdate{Content: "deprecated flag -x", Type: Msg.Warning}
+}
+
+// typedEditor reports the result of Change through ChangeTyped
+type typedEditor struct{ value string }
+
+func (e *typedEditor) Name() string                                   { return "Editor" }
+func (e *typedEditor) Label() string                                  { return "Edit" }
+func (e *typedEditor) Value() string                                  { return e.value }
+func (e *typedEditor) Change(newValue string, progress chan<- string) { e.value = newValue }
+func (e *typedEditor) ChangeTyped(newValue string, progress chan<- MessageUpdate) {
+	e.value = newValue
+	progress <- MessageUpdate{Content: "error log rotated", Type: Msg.Info}
+}
+
+// collectUpdates runs the handler's execution path and returns the updates it received
+func collectUpdates(a *anyHandler, newValue string) []MessageUpdate {
+	progress := make(chan MessageUpdate, 10)
+	a.changeTyped(newValue, progress)
+	close(progress)
+	var updates []MessageUpdate
+	for u := range progress {
+		updates = append(updates, u)
+	}
+	return updates
+}
+
+func TestExecuteTypedKeepsMessageTypes(t *testing.T) {
+	h := NewTUI(&TuiConfig{
+		AppName:  "Typed",
+		ExitChan: make(chan bool),
+		Logger:   func(...any) {},
+	})
+	tab := h.NewTabSection("Lint", "Typed progress test")
+	h.AddHandler(&typedLinter{}, time.Second, "", tab)
+	ts := tab.(*tabSection)
+	f := ts.fieldHandlers[0]
+
+	updates := collectUpdates(f.handler, "")
+	want := []MessageUpdate{{Content: "0 error patterns found", Type: Msg.Success}, {Content: "deprecated flag -x", Type: Msg.Warning}}
+	if len(updates) != len(want) || updates[0] != want[0] || updates[1] != want[1] {
+		t.Fatalf("Expected the ExecuteTyped updates as sent %v, got %v", want, updates)
+	}
+
+	// Through the real async path the rendered line keeps the type, with no encoded prefix
+	f.executeAsyncChange("")
+	ts.mu.RLock()
+	defer ts.mu.RUnlock()
+	var found bool
+	for _, c := range ts.tabContents {
+		if strings.Contains(c.Content, "\x1f") {
+			t.Errorf("Expected no control characters in content, got %q", c.Content)
+		}
+		if c.Content == "deprecated flag -x" { // one operation line: the last update stays
+			found = true
+			if c.Type != Msg.Warning {
+				t.Errorf("Expected warning line, got type %v", c.Type)
+			}
+		}
+	}
+	if !found {
+		t.Error("Expected the typed update in the tab")
+	}
+}
+
+func TestChangeTypedPreferredOverChange(t *testing.T) {
+	h := DefaultTUIForTest()
+	tab := h.NewTabSection("Edit", "Typed progress test")
+	editor := &typedEditor{value: "a"}
+	h.AddHandler(editor, 0, "", tab)
+	ts := tab.(*tabSection)
+
+	updates := collectUpdates(ts.fieldHandlers[0].handler, "b")
+
+	if editor.value != "b" {
+		t.Errorf("Expected ChangeTyped to receive the new value, got %q", editor.value)
+	}
+	if len(updates) != 1 || updates[0] != (MessageUpdate{Content: "error log rotated", Type: Msg.Info}) {
+		t.Fatalf("Expected the info update from ChangeTyped, got %v", updates)
+	}
+}
+
+func TestPlainProgressDetectsType(t *testing.T) {
+	h := DefaultTUIForTest()
+	tab := h.NewTabSection("Edit", "Typed progress test")
+	handler := NewTestEditableHandler("Host", "localhost")
+	h.AddHandler(handler, 0, "", tab)
+
+	a := tab.(*tabSection).fieldHandlers[0].handler
+	a.changeFunc = func(_ string, progress chan<- string) {
+		progress <- "build error"
+	}
+	updates := collectUpdates(a, "")
+	if len(updates) != 1 || updates[0].Type != Msg.Error {
+		t.Fatalf("Expected plain text to keep type detection, got %v", updates)
+	}
+}
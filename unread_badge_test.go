@@ -0,0 +1,105 @@
+package devtui
+
+import (
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestUnreadBadgeForInactiveTab(t *testing.T) {
+	h := DefaultTUIForTest()
+	h.viewport.Width = 120
+	h.viewport.Height = 20
+
+	build := h.NewTabSection("Build", "Compiler")
+	logs := h.NewTabSection("Logs", "Background logs")
+	h.activeTab = build.(*tabSection).index
+
+	log := h.AddLogger("Background", false, "", logs)
+	log("first")
+	log("second")
+	log("third")
+
+	ts := logs.(*tabSection)
+	if ts.messageCount != 3 || ts.unread() != 3 {
+		t.Fatalf("Expected 3 messages and 3 unread, got %d and %d", ts.messageCount, ts.unread())
+	}
+	if header := h.headerView(); !strings.Contains(header, "Logs(3)") {
+		t.Errorf("Expected header to contain 'Logs(3)', got %q", header)
+	}
+
+	// Navigate with Tab until Logs becomes active: unread is reset and badge disappears
+	for h.activeTab != ts.index {
+		h.handleKeyboard(tea.KeyMsg{Type: tea.KeyTab})
+	}
+	if ts.unread() != 0 {
+		t.Errorf("Expected unread reset after activating tab, got %d", ts.unread())
+	}
+	if header := h.headerView(); strings.Contains(header, "Logs(") {
+		t.Errorf("Active tab should not show a badge, got %q", header)
+	}
+	if ts.messageCount != 3 {
+		t.Errorf("Message count should be kept, got %d", ts.messageCount)
+	}
+}
+
+func TestActiveTabMessagesAreNotUnread(t *testing.T) {
+	h := DefaultTUIForTest()
+	logs := h.NewTabSection("Logs", "Background logs")
+	h.activeTab = logs.(*tabSection).index
+
+	log := h.AddLogger("Foreground", false, "", logs)
+	log("visible")
+
+	if got := logs.(*tabSection).unread(); got != 0 {
+		t.Errorf("Expected no unread messages on active tab, got %d", got)
+	}
+}
+
+func TestUnreadCountingWhileSwitchingTabs(t *testing.T) {
+	h := DefaultTUIForTest()
+	h.viewport.Width = 120
+	h.viewport.Height = 20
+	build := h.NewTabSection("Build", "Compiler")
+	logs := h.NewTabSection("Logs", "Background logs")
+	h.activeTab = build.(*tabSection).index
+	log := h.AddLogger("Background", false, "", logs)
+
+	// Drain UI notifications as the running program would
+	stop := make(chan struct{})
+	defer close(stop)
+	go func() {
+		for {
+			select {
+			case <-h.tabContentsChan:
+			case <-stop:
+				return
+			}
+		}
+	}()
+
+	// Producers only count messages; the UI goroutine (Update) owns activeTab
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for i := 0; i < 200; i++ {
+			log("line", i)
+		}
+	}()
+	for i := 0; i < 50; i++ {
+		h.Update(tea.KeyMsg{Type: tea.KeyTab})
+	}
+	<-done
+
+	for h.activeTab != build.(*tabSection).index {
+		h.Update(tea.KeyMsg{Type: tea.KeyTab})
+	}
+	ts := logs.(*tabSection)
+	ts.mu.RLock()
+	total := ts.messageCount
+	ts.mu.RUnlock()
+	if got := ts.unread(); got < 0 || got > total {
+		t.Errorf("Expected unread between 0 and %d, got %d", total, got)
+	}
+}
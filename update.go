@@ -13,7 +13,22 @@ import (
 func (h *DevTUI) listenToMessages() tea.Cmd {
 	return func() tea.Msg {
 		msg := <-h.tabContentsChan
-		return channelMsg(msg)
+		if h.WriteCoalesceInterval <= 0 {
+			return channelMsg(msg)
+		}
+
+		// Coalescing: collect everything that arrives within the window into one update
+		batch := channelBatchMsg{msg}
+		timer := time.NewTimer(h.WriteCoalesceInterval)
+		defer timer.Stop()
+		for {
+			select {
+			case next := <-h.tabContentsChan:
+				batch = append(batch, next)
+			case <-timer.C:
+				return batch
+			}
+		}
 	}
 }
 
@@ -26,6 +41,7 @@ func (h *DevTUI) tickEverySecond() tea.Cmd {
 
 // Update maneja las actualizaciones del estado
 func (h *DevTUI) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	defer h.markActiveRead() // unread counts are settled here, on the UI goroutine
 	var (
 		cmds []tea.Cmd
 		cmd  tea.Cmd
@@ -57,11 +73,40 @@ func (h *DevTUI) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			h.updateViewport()
 		}
 
+	case channelBatchMsg: // Coalesced messages (TuiConfig.WriteCoalesceInterval)
+		cmds = append(cmds, h.listenToMessages())
+
+		// One viewport update for the whole batch if any message belongs to the active tab
+		for _, tc := range msg {
+			if tc.tabSection.index == h.activeTab {
+				h.updateViewport()
+				break
+			}
+		}
+
 	case refreshTabMsg: // Handle manual refresh requests from external tools
 		// Update viewport for the currently active tab
 		h.updateViewport()
 
+	case shortcutTimeoutMsg: // No key followed the pending keys in time: run them alone
+		if msg.id == h.pendingKeysID {
+			if cmd, _ := h.flushPendingKeys(); cmd != nil {
+				cmds = append(cmds, cmd)
+			}
+		}
+
+	case colorPaletteMsg: // Theme switch (SetColorPalette)
+		h.applyColorPalette(msg.palette)
+
+	case refreshDisplayMsg: // Redraw only if the display handler is the one on screen
+		if h.displayVisible(msg.tab, msg.name) {
+			h.updateViewport()
+		}
+
 	case tea.WindowSizeMsg: // update the viewport size
+		h.windowHeight = msg.Height
+		h.windowWidth = msg.Width
+		h.clampEditCursor()
 
 		headerHeight := lipgloss.Height(h.headerView())
 		footerHeight := lipgloss.Height(h.footerView())
@@ -73,7 +118,7 @@ func (h *DevTUI) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			// we can initialize the viewport. The initial dimensions come in
 			// quickly, though asynchronously, which is why we wait for them
 			// here.
-			h.viewport = viewport.New(msg.Width, msg.Height-verticalMarginHeight)
+			h.viewport = viewport.New(msg.Width, max(0, msg.Height-verticalMarginHeight))
 			h.viewport.YPosition = headerHeight
 			// Disable mouse wheel to enable terminal text selection
 			h.viewport.MouseWheelEnabled = false
@@ -81,12 +126,17 @@ func (h *DevTUI) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			h.ready = true
 		} else {
 			h.viewport.Width = msg.Width
-			h.viewport.Height = msg.Height - verticalMarginHeight
+			h.viewport.Height = max(0, msg.Height-verticalMarginHeight)
 		}
 
 	case tickMsg: // update the time every second
 		h.currentTime = time.Now().Format("15:04:05")
 		cmds = append(cmds, h.tickEverySecond())
+		h.redrawMissedUpdates() // updates skipped by TuiConfig.OverflowPolicy
+		// Relative timestamps ("5s", "2m") age every second: re-render keeping scroll position
+		if h.TimestampMode == TimestampRelative && h.ready {
+			h.viewport.SetContent(h.ContentView())
+		}
 
 	case tea.FocusMsg:
 		h.focused = true
@@ -105,8 +155,36 @@ func (h *DevTUI) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 }
 
 func (h *DevTUI) updateViewport() {
+	// Sticky bottom: follow new content only when the user was already at the bottom
+	// (or the displayed tab changed); otherwise keep the position and flag new messages.
+	// TuiConfig.AlwaysFollow restores unconditional scrolling to the newest message.
+	follow := h.AlwaysFollow || h.viewport.AtBottom() || h.viewportTab != h.activeTab
+	h.viewportTab = h.activeTab
 	h.viewport.SetContent(h.ContentView())
+	h.viewport.SetXOffset(h.xOffset) // keep the horizontal scroll within the new content
+	if follow {
+		h.jumpToNewest()
+	} else {
+		h.newMessagesBelow = max(h.activeMessageCount()-h.seenMessages, 0)
+	}
+}
+
+// jumpToNewest scrolls to the bottom and clears the "↓ N new" indicator
+func (h *DevTUI) jumpToNewest() {
 	h.viewport.GotoBottom()
+	h.newMessagesBelow = 0
+	h.seenMessages = h.activeMessageCount()
+}
+
+// activeMessageCount returns the number of messages received by the active tab
+func (h *DevTUI) activeMessageCount() int {
+	if h.activeTab >= len(h.TabSections) {
+		return 0
+	}
+	ts := h.TabSections[h.activeTab]
+	ts.mu.RLock()
+	defer ts.mu.RUnlock()
+	return ts.messageCount
 }
 
 // RefreshUI updates the TUI display for the currently active tab.
@@ -120,19 +198,37 @@ func (h *DevTUI) updateViewport() {
 //
 //	tui.RefreshUI() // Triggers a UI refresh for the active tab
 func (h *DevTUI) RefreshUI() {
-	// Only update if the TUI is actively running and ready
-	if h.tea == nil || !h.ready {
-		return
-	}
-
 	// Send a custom message to the tea.Program to trigger a view update
 	// This is thread-safe and non-blocking
-	h.tea.Send(refreshTabMsg{})
+	h.sendWhenRunning(refreshTabMsg{})
+}
+
+// sendWhenRunning delivers msg to the tea.Program only if the TUI is actively running and ready
+func (h *DevTUI) sendWhenRunning(msg tea.Msg) {
+	if !h.running.Load() || h.tea == nil || !h.ready {
+		return
+	}
+	h.tea.Send(msg)
 }
 
 // refreshTabMsg is an internal message type for triggering tab refreshes
 type refreshTabMsg struct{}
 
+// refreshDisplayMsg asks to redraw a display handler (tabSection.RefreshDisplay)
+type refreshDisplayMsg struct {
+	tab  *tabSection
+	name string
+}
+
+// displayVisible reports whether the display handler name of tab is the field shown in the viewport
+func (h *DevTUI) displayVisible(tab *tabSection, name string) bool {
+	if h.activeTab >= len(h.TabSections) || h.TabSections[h.activeTab] != tab {
+		return false
+	}
+	field := tab.activeField()
+	return field != nil && field.isDisplayOnly() && field.handler.Name() == name
+}
+
 func (h *DevTUI) editingConfigOpen(open bool, currentField *field, msg string) {
 
 	if open {
@@ -143,6 +239,8 @@ func (h *DevTUI) editingConfigOpen(open bool, currentField *field, msg string) {
 
 	if currentField != nil {
 		currentField.setCursorAtEnd()
+		currentField.history.reset() // undo/redo only spans one edit session
+		currentField.selected = open && currentField.tempEditValue != "" && currentField.selectAllOnEdit()
 	}
 
 	if msg != "" {
@@ -9,6 +9,22 @@ import (
 // handleKeyboard processes keyboard input and updates the model state
 // returns whether the update function should continue processing or return early
 func (h *DevTUI) handleKeyboard(msg tea.KeyMsg) (bool, tea.Cmd) {
+	defer h.syncFocus() // OnBlur/OnFocus when navigation changed the active field
+
+	if h.pendingClear { // Esperando confirmación de borrado (TuiConfig.ConfirmClear)
+		return h.handleClearConfirmation(msg)
+	}
+	if h.showGlobalHelp { // Ayuda global abierta: la tecla solo la cierra
+		return h.handleGlobalHelpKeyboard(msg)
+	}
+	if msg.Type == tea.KeyF1 {
+		h.openGlobalHelp()
+		return false, nil
+	}
+	if h.fieldHelpOpen() && (msg.Type == tea.KeyEsc || msg.String() == fieldHelpMark) {
+		h.showFieldHelp = false // Esc o ? cierran la ayuda del campo
+		return false, nil
+	}
 	if h.editModeActivated { // EDITING CONFIG IN SECTION
 		return h.handleEditingConfigKeyboard(msg)
 	} else {
@@ -19,14 +35,23 @@ func (h *DevTUI) handleKeyboard(msg tea.KeyMsg) (bool, tea.Cmd) {
 // handleEditingConfigKeyboard handles keyboard input while in config editing mode
 func (h *DevTUI) handleEditingConfigKeyboard(msg tea.KeyMsg) (bool, tea.Cmd) {
 	currentTab := h.TabSections[h.activeTab]
-	fieldHandlers := currentTab.fieldHandlers
-	currentField := fieldHandlers[currentTab.indexActiveEditField]
+	currentField := currentTab.activeField()
+	if currentField == nil { // Sin campos no hay nada que editar
+		h.editModeActivated = false
+		return true, nil
+	}
 
 	if currentField.editable() { // Si el campo es editable, permitir la edición
 		// Calcular el ancho máximo disponible para el texto
 		// Esto sigue la misma lógica que en footerInput.go
 		_, availableTextWidth := h.calculateInputWidths(currentField.handler.Label())
 
+		// Primera tecla con el valor seleccionado (SelectAllOnEdit): escribir lo reemplaza
+		currentField.replaceSelection(msg.Type)
+		if msg.Type != tea.KeyTab {
+			currentField.completion = nil // cualquier otra tecla termina el ciclo de completado
+		}
+
 		switch msg.Type {
 		case tea.KeyEnter: // Guardar cambios o ejecutar acción
 			// Verificar si hubo cambios (incluyendo borrar el contenido)
@@ -46,11 +71,47 @@ func (h *DevTUI) handleEditingConfigKeyboard(msg tea.KeyMsg) (bool, tea.Cmd) {
 			return false, nil
 
 		case tea.KeyEsc: // Al presionar ESC, descartamos los cambios y salimos del modo edición
-			currentField.tempEditValue = "" // Limpiar el valor temporal
+			if !currentField.preserveOnEsc() {
+				currentField.tempEditValue = "" // Limpiar el valor temporal
+			}
 			h.editingConfigOpen(false, currentField, "")
 			h.updateViewport() // Asegurar que se actualice la vista para mostrar el mensaje
 			return false, nil
 
+		case tea.KeyTab: // Guardar y avanzar al siguiente campo/tab (TuiConfig.TabTraversal)
+			if currentField.completes() { // Completer/HandlerFilePicker: Tab completa el valor
+				currentField.complete(availableTextWidth)
+				return false, nil
+			}
+			if h.TabTraversal {
+				h.traverseToNextField(currentField)
+				return false, nil
+			}
+
+		case tea.KeyCtrlR: // Restaurar el valor por defecto (DefaultValue) sin guardar
+			if currentField.resetToDefault() {
+				currentField.notifyEdit()
+			}
+
+		case tea.KeyCtrlZ: // Deshacer la última modificación
+			if currentField.undoEdit() {
+				currentField.notifyEdit()
+			}
+
+		case tea.KeyCtrlY: // Rehacer la modificación deshecha
+			if currentField.redoEdit() {
+				currentField.notifyEdit()
+			}
+
+		case tea.KeyUp, tea.KeyDown: // Campos numéricos (Numeric()): incrementar/decrementar el valor
+			delta := 1
+			if msg.Type == tea.KeyDown {
+				delta = -1
+			}
+			if currentField.stepNumeric(delta) {
+				return false, nil
+			}
+
 		case tea.KeyLeft: // Mover el cursor a la izquierda dentro del texto
 			if currentField.cursor > 0 {
 				currentField.cursor--
@@ -75,9 +136,11 @@ func (h *DevTUI) handleEditingConfigKeyboard(msg tea.KeyMsg) (bool, tea.Cmd) {
 				// Convert to runes to handle multi-byte characters correctly
 				runes := []rune(currentField.tempEditValue)
 				if currentField.cursor <= len(runes) {
+					currentField.snapshotEdit()
 					newRunes := slices.Delete(runes, currentField.cursor-1, currentField.cursor)
 					currentField.tempEditValue = string(newRunes)
 					currentField.cursor--
+					currentField.notifyEdit()
 				}
 			}
 
@@ -92,8 +155,9 @@ func (h *DevTUI) handleEditingConfigKeyboard(msg tea.KeyMsg) (bool, tea.Cmd) {
 				currentField.cursor = len(runes)
 			}
 
-			// Verificar si agregar un espacio excedería el ancho disponible
-			if len(runes)+1 < availableTextWidth {
+			// Verificar si agregar un espacio excedería el ancho disponible o MaxLength()
+			if len(runes)+1 < availableTextWidth && !currentField.exceedsMaxLength(len(runes)+1) {
+				currentField.snapshotEdit()
 				// Insert the space at cursor position
 				newRunes := make([]rune, 0, len(runes)+1)
 				newRunes = append(newRunes, runes[:currentField.cursor]...)
@@ -101,6 +165,7 @@ func (h *DevTUI) handleEditingConfigKeyboard(msg tea.KeyMsg) (bool, tea.Cmd) {
 				newRunes = append(newRunes, runes[currentField.cursor:]...)
 				currentField.tempEditValue = string(newRunes)
 				currentField.cursor++
+				currentField.notifyEdit()
 			}
 
 		case tea.KeyRunes:
@@ -113,18 +178,25 @@ func (h *DevTUI) handleEditingConfigKeyboard(msg tea.KeyMsg) (bool, tea.Cmd) {
 					currentField.cursor = len(runes)
 				}
 
+				inserted := msg.Runes
+				if msg.Paste { // Texto pegado: una sola línea, recortado a lo que aún cabe
+					inserted = pasteRunes(msg.Runes, currentField.pasteRoom(len(runes), availableTextWidth))
+				}
+
 				// Verificar si agregar los nuevos caracteres excedería el ancho disponible
-				totalChars := len(runes) + len(msg.Runes)
-				if totalChars < availableTextWidth {
+				totalChars := len(runes) + len(inserted)
+				if len(inserted) > 0 && totalChars < availableTextWidth && !currentField.exceedsMaxLength(totalChars) {
+					currentField.snapshotEdit()
 					// Insert the new runes at cursor position
-					newRunes := make([]rune, 0, len(runes)+len(msg.Runes))
+					newRunes := make([]rune, 0, totalChars)
 					newRunes = append(newRunes, runes[:currentField.cursor]...)
-					newRunes = append(newRunes, msg.Runes...)
+					newRunes = append(newRunes, inserted...)
 					newRunes = append(newRunes, runes[currentField.cursor:]...)
 					currentField.tempEditValue = string(newRunes)
-					currentField.cursor += len(msg.Runes)
+					currentField.cursor += len(inserted)
+					currentField.notifyEdit()
 				}
-				// Si excede el ancho, simplemente no agregar los caracteres
+				// Si excede el ancho o MaxLength(), simplemente no agregar los caracteres
 			}
 		}
 	} else { // Si el campo no es editable, solo ejecutar la acción
@@ -143,20 +215,76 @@ func (h *DevTUI) handleEditingConfigKeyboard(msg tea.KeyMsg) (bool, tea.Cmd) {
 			h.editingConfigOpen(false, currentField, "")
 			h.updateViewport() // Asegurar que se actualice la vista para mostrar el mensaje
 			return false, nil
+
+		case tea.KeyTab: // Avanzar al siguiente campo/tab (TuiConfig.TabTraversal)
+			if h.TabTraversal {
+				h.traverseToNextField(currentField)
+				return false, nil
+			}
 		}
 	}
 
 	return true, nil
 }
 
+// traverseToNextField saves the field being edited and moves focus to the next field.
+// After the last field of a tab it continues on the first field of the next tab with fields.
+// The new field opens in edit mode when it is editable.
+func (h *DevTUI) traverseToNextField(currentField *field) {
+	// Save like Enter does, only when the value changed
+	if currentField.editable() && currentField.tempEditValue != currentField.Value() {
+		currentField.handleEnter()
+	}
+	currentField.tempEditValue = ""
+	h.editingConfigOpen(false, currentField, "")
+
+	tab := h.TabSections[h.activeTab]
+	if next := tab.nextFocusable(tab.indexActiveEditField + 1); next >= 0 {
+		tab.indexActiveEditField = next
+	} else {
+		for i := 1; i <= len(h.TabSections); i++ {
+			nextTab := (h.activeTab + i) % len(h.TabSections)
+			if first := h.TabSections[nextTab].nextFocusable(0); first >= 0 {
+				h.switchTab(nextTab)
+				h.TabSections[nextTab].indexActiveEditField = first
+				break
+			}
+		}
+	}
+
+	tab = h.TabSections[h.activeTab]
+	nextField := tab.fieldHandlers[tab.indexActiveEditField]
+	if nextField.editable() {
+		nextField.tempEditValue = nextField.Value()
+		h.editingConfigOpen(true, nextField, "")
+	}
+	h.updateViewport()
+	h.checkAndTriggerInteractiveContent()
+}
+
 // handleNormalModeKeyboard handles keyboard input in normal mode (not editing config)
 func (h *DevTUI) handleNormalModeKeyboard(msg tea.KeyMsg) (bool, tea.Cmd) {
 	currentTab := h.TabSections[h.activeTab]
 	fieldHandlers := currentTab.fieldHandlers
 	totalFields := len(fieldHandlers)
 
+	if msg.Type != tea.KeyRunes { // Any other key cancels a pending shortcut sequence
+		h.pendingKeys = ""
+	}
+
 	switch msg.Type {
 	case tea.KeyUp, tea.KeyDown:
+		// Display con ContentHeight(): scroll interno del contenido del campo activo
+		if totalFields > 0 {
+			delta := 1
+			if msg.Type == tea.KeyUp {
+				delta = -1
+			}
+			if currentTab.activeField().scrollContent(delta) {
+				h.viewport.SetContent(h.ContentView())
+				return false, nil
+			}
+		}
 		// Las teclas arriba y abajo controlan el scroll línea por línea del viewport
 		// No modifican el campo activo, solo el scroll del contenido
 		// No hacemos nada aquí para permitir que el manejo del viewport siga su curso normal
@@ -169,9 +297,45 @@ func (h *DevTUI) handleNormalModeKeyboard(msg tea.KeyMsg) (bool, tea.Cmd) {
 		h.viewport.PageDown()
 		return false, nil
 
+	case tea.KeyCtrlHome, tea.KeyHome: // Ir al inicio del contenido
+		h.viewport.GotoTop()
+		return false, nil
+
+	case tea.KeyCtrlEnd, tea.KeyEnd: // Ir al final del contenido (limpia el indicador "↓ N new")
+		h.jumpToNewest()
+		return false, nil
+
+	case tea.KeyCtrlU: // Media página hacia arriba (estilo vim)
+		h.viewport.HalfViewUp()
+		return false, nil
+
+	case tea.KeyCtrlD: // Media página hacia abajo (estilo vim)
+		h.viewport.HalfViewDown()
+		return false, nil
+
+	case tea.KeyCtrlL: // Borrar los mensajes del tab activo
+		h.requestClear()
+		return false, nil
+
+	case tea.KeyCtrlT: // Mostrar/ocultar timestamps de los mensajes
+		h.toggleTimestamps()
+		return false, nil
+
+	case tea.KeyCtrlN: // Mostrar/ocultar los nombres de los handlers
+		h.toggleHandlerNames()
+		return false, nil
+
+	case tea.KeyShiftLeft: // Scroll horizontal hacia la izquierda (TuiConfig.HorizontalScrollStep)
+		h.scrollHorizontal(-h.HorizontalScrollStep)
+		return false, nil
+
+	case tea.KeyShiftRight: // Scroll horizontal hacia la derecha
+		h.scrollHorizontal(h.HorizontalScrollStep)
+		return false, nil
+
 	case tea.KeyLeft: // Navegar al campo anterior (ciclo continuo)
 		if totalFields > 0 {
-			currentTab.indexActiveEditField = (currentTab.indexActiveEditField - 1 + totalFields) % totalFields
+			currentTab.indexActiveEditField = currentTab.cycleFocusable(currentTab.indexActiveEditField-1, -1)
 			h.updateViewport()
 			h.checkAndTriggerInteractiveContent() // NEW: Auto-trigger content for interactive handlers
 			return false, nil                     // Detener procesamiento adicional
@@ -179,26 +343,25 @@ func (h *DevTUI) handleNormalModeKeyboard(msg tea.KeyMsg) (bool, tea.Cmd) {
 
 	case tea.KeyRight: // Navegar al campo siguiente (ciclo continuo)
 		if totalFields > 0 {
-			currentTab.indexActiveEditField = (currentTab.indexActiveEditField + 1) % totalFields
+			currentTab.indexActiveEditField = currentTab.cycleFocusable(currentTab.indexActiveEditField+1, 1)
 			h.updateViewport()
 			h.checkAndTriggerInteractiveContent() // NEW: Auto-trigger content for interactive handlers
 			return false, nil                     // Detener procesamiento adicional
 		}
 
 	case tea.KeyTab: // cambiar tabSection
-		h.activeTab = (h.activeTab + 1) % len(h.TabSections)
+		h.switchTab((h.activeTab + 1) % len(h.TabSections))
 		h.updateViewport()
 		h.checkAndTriggerInteractiveContent() // NEW: Auto-trigger content for interactive handlers
 
 	case tea.KeyShiftTab: // cambiar tabSection
-		h.activeTab = (h.activeTab - 1 + len(h.TabSections)) % len(h.TabSections)
+		h.switchTab((h.activeTab - 1 + len(h.TabSections)) % len(h.TabSections))
 		h.updateViewport()
 		h.checkAndTriggerInteractiveContent() // NEW: Auto-trigger content for interactive handlers
 
 	case tea.KeyEnter: //Enter para entrar en modo edición, ejecuta la acción directamente si el campo no es editable
 		if totalFields > 0 {
-			fieldHandlers := currentTab.fieldHandlers
-			field := fieldHandlers[currentTab.indexActiveEditField]
+			field := currentTab.activeField()
 			if !field.editable() {
 				// Trigger async operation for non-editable fields
 				if field.handler != nil {
@@ -206,24 +369,47 @@ func (h *DevTUI) handleNormalModeKeyboard(msg tea.KeyMsg) (bool, tea.Cmd) {
 				}
 			} else {
 				// Para campos editables, activar modo de edición explícitamente
-				field.tempEditValue = field.Value()
+				resume := field.preserveOnEsc() && field.tempEditValue != "" // borrador conservado con Esc
+				if !resume {
+					field.tempEditValue = field.Value()
+				}
 				field.cursor = 0 // Asegurarnos de que el cursor comience al principio
 				h.editModeActivated = true
 				h.editingConfigOpen(true, field, "")
+				if resume {
+					field.cursor = len([]rune(field.tempEditValue))
+					field.selected = false // se continúa el borrador, no se reemplaza
+				}
 			}
 			h.updateViewport()
 		}
 
-	case tea.KeyRunes: // NEW: Handle single character shortcuts
-		if len(msg.Runes) == 1 {
+	case tea.KeySpace: // Space cambia el estado de un HandlerToggle, o pausa la salida de un tab sin campos
+		if totalFields > 0 {
+			if field := currentTab.activeField(); field.isToggleHandler() {
+				field.handleEnter()
+				h.updateViewport()
+				return false, nil
+			}
+		} else {
+			currentTab.togglePause()
+			h.updateViewport()
+			return false, nil
+		}
+
+	case tea.KeyRunes: // NEW: Handle single character and multi-key (eg: "gb") shortcuts
+		if len(msg.Runes) == 1 && !msg.Paste { // texto pegado no dispara atajos
 			key := string(msg.Runes[0])
-			if entry, exists := h.shortcutRegistry.Get(key); exists {
-				return h.executeShortcut(entry)
+			if cmd, consumed := h.handleShortcutKey(key); consumed {
+				return false, cmd
+			}
+			if h.runBuiltinKey(key) {
+				return false, nil
 			}
 		}
 
 	case tea.KeyCtrlC:
-		close(h.ExitChan) // Cerrar el canal para señalizar a todas las goroutines
+		h.closeExitChan() // Cerrar el canal para señalizar a todas las goroutines (una sola vez)
 		// Usar tea.Sequence para asegurar que ExitAltScreen se ejecute antes de Quit
 		return false, tea.Sequence(tea.ExitAltScreen, tea.Quit)
 	}
@@ -231,20 +417,44 @@ func (h *DevTUI) handleNormalModeKeyboard(msg tea.KeyMsg) (bool, tea.Cmd) {
 	return true, nil
 }
 
-// checkAndTriggerInteractiveContent checks if the active field is interactive and triggers content display automatically
-func (h *DevTUI) checkAndTriggerInteractiveContent() {
+// runBuiltinKey runs the built-in action of a single key, used when no handler registered
+// it as a shortcut. Reports whether key has one.
+func (h *DevTUI) runBuiltinKey(key string) bool {
 	if h.activeTab >= len(h.TabSections) {
-		return
+		return false
 	}
+	currentTab := h.TabSections[h.activeTab]
 
-	activeTab := h.TabSections[h.activeTab]
-	fieldHandlers := activeTab.fieldHandlers
+	switch key {
+	case "Y": // Shift+Y copies the whole active tab
+		h.copyActiveTab()
+	case "g": // g/G jump to the top/bottom of the content
+		h.viewport.GotoTop()
+	case "G":
+		h.jumpToNewest()
+	case "e": // e cycles the tab's severity filter (all / warnings+ / errors only)
+		currentTab.cycleSeverityFilter()
+		h.updateViewport()
+	case fieldHelpMark: // ? opens the Help() of the active field (or the global help when it has none)
+		if h.activeFieldHelp() == "" {
+			h.openGlobalHelp()
+			return true
+		}
+		h.syncFocus() // el cambio de campo pendiente no debe cerrarla
+		h.showFieldHelp = true
+	default:
+		return false
+	}
+	return true
+}
 
-	if len(fieldHandlers) == 0 || activeTab.indexActiveEditField >= len(fieldHandlers) {
+// checkAndTriggerInteractiveContent checks if the active field is interactive and triggers content display automatically
+func (h *DevTUI) checkAndTriggerInteractiveContent() {
+	if h.activeTab >= len(h.TabSections) {
 		return
 	}
 
-	activeField := fieldHandlers[activeTab.indexActiveEditField]
+	activeField := h.TabSections[h.activeTab].activeField()
 	if activeField != nil && activeField.isInteractiveHandler() && !h.editModeActivated {
 		// Trigger content display for interactive handlers when field is selected
 		activeField.triggerContentDisplay()
@@ -273,28 +483,17 @@ func (h *DevTUI) executeShortcut(entry *ShortcutEntry) (bool, tea.Cmd) {
 	targetField := fieldHandlers[entry.FieldIndex]
 
 	// Navigate to target tab if not already there
-	if h.activeTab != entry.TabIndex {
-		h.activeTab = entry.TabIndex
-	}
+	h.switchTab(entry.TabIndex)
 
 	// Set active field
 	targetTab.indexActiveEditField = entry.FieldIndex
 
-	// Execute the Change method with shortcut value
-	if targetField.handler != nil {
-		progressChan := make(chan string, 10)
-		done := make(chan struct{})
-		go func() {
-			for msg := range progressChan {
-				targetField.sendMessage(msg)
-			}
-			close(done)
-		}()
-		go func() {
-			targetField.handler.Change(entry.Value, progressChan)
-			close(progressChan)
-		}()
-		<-done
+	// Run through the same goroutine+timeout path as Enter (synchronous in test mode).
+	// Execution handlers take no value: they run as if Enter was pressed.
+	if targetField.isExecutionHandler() {
+		targetField.handleEnter()
+	} else {
+		targetField.handleEnterWithValue(entry.Value)
 	}
 
 	// Update viewport to show changes
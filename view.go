@@ -1,46 +1,107 @@
 package devtui
 
 import (
+	"strings"
+
 	. "github.com/cdvelop/tinystring"
 	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/ansi"
 )
 
 func (h *DevTUI) View() string {
 	if !h.ready {
 		return "\n  Initializing..."
 	}
-	return Fmt("%s\n%s\n%s", h.headerView(), h.viewport.View(), h.footerView())
+	if h.tooSmall() {
+		return h.tooSmallView()
+	}
+	return h.frameView()
 	// return Fmt("%s\n%s\n%s", h.headerView(), h.ContentView(), h.footerView())
 }
 
+// frameView composes header, viewport and footer (View once the window size is known)
+func (h *DevTUI) frameView() string {
+	header, footer := h.headerView(), h.footerView()
+	h.fitViewportHeight(lipgloss.Height(header) + lipgloss.Height(footer))
+	content := h.viewportView()
+	if box := h.overlayBox(); box != "" {
+		content = placeOverlay(content, box, h.viewport.Width)
+	}
+	return Fmt("%s\n%s\n%s", header, content, footer)
+}
+
+// RenderFrame returns the full screen (header, content and footer) composed exactly like
+// View, with the viewport refreshed from the active tab, for layout snapshot tests.
+// With plain set, ANSI styling is stripped for golden-file comparison.
+func (h *DevTUI) RenderFrame(plain bool) string {
+	h.updateViewport()
+	frame := h.frameView()
+	if plain {
+		return ansi.Strip(frame)
+	}
+	return frame
+}
+
+// fitViewportHeight keeps header + viewport + footer within the window when the footer
+// grows or shrinks (e.g. a tab status line appears)
+func (h *DevTUI) fitViewportHeight(marginHeight int) {
+	if h.windowHeight == 0 {
+		return
+	}
+	if height := h.windowHeight - marginHeight; height > 0 {
+		h.viewport.Height = height
+	}
+}
+
 // ContentView renderiza los mensajes para una sección de contenido
 func (h *DevTUI) ContentView() string {
 	if len(h.TabSections) == 0 {
 		return "No tabs created yet"
 	}
 	if h.activeTab >= len(h.TabSections) {
-		h.activeTab = 0
+		h.setActiveIndex(0)
 	}
 
 	// Proteger el acceso a tabContents con mutex
 	section := h.TabSections[h.activeTab]
 	section.mu.RLock()
-	tabContent := make([]tabContent, len(section.tabContents)) // Copia para evitar retener el lock
-	copy(tabContent, section.tabContents)
+	tabContent := make([]tabContent, 0, len(section.tabContents)) // Copia para evitar retener el lock
+	for _, content := range section.renderedContents() { // snapshot mientras está en pausa
+		if section.severity.allows(content.Type) { // filtro de severidad ("e"), los mensajes se conservan
+			tabContent = append(tabContent, content)
+		}
+	}
 	section.mu.RUnlock()
 
 	var contentLines []string
 
-	// NEW: Add display handler content if active field is a Display handler
+	// Non-focusable display handlers can't be selected: their content is always shown
 	fieldHandlers := section.fieldHandlers
-	if len(fieldHandlers) > 0 && section.indexActiveEditField < len(fieldHandlers) {
-		activeField := fieldHandlers[section.indexActiveEditField]
-		if activeField.hasContentMethod() {
+	highlightStyle := h.textContentStyle.Foreground(lipgloss.Color(h.Primary))
+	for i, f := range fieldHandlers {
+		if i == section.indexActiveEditField || f.focusable() || !f.hasContentMethod() {
+			continue
+		}
+		if content := f.getDisplayContent(); content != "" {
+			contentLines = append(contentLines, highlightStyle.Render(content), "")
+		}
+	}
+
+	// NEW: Add display handler content if active field is a Display handler
+	if activeField := section.activeField(); activeField != nil {
+		if activeField.hasContentMethod() || activeField.isTableHandler() {
 			displayContent := activeField.getDisplayContent()
+			if activeField.isTableHandler() {
+				displayContent = h.renderTable(activeField.handler.headersFunc(), activeField.handler.rowsFunc())
+			}
 			if displayContent != "" {
+				// Long content with ContentHeight() scrolls inside its own region
+				displayContent, indicator := activeField.contentWindow(displayContent)
 				// Add display content at the top of the content view with Primary color
-				highlightStyle := h.textContentStyle.Foreground(lipgloss.Color(h.Primary))
 				contentLines = append(contentLines, highlightStyle.Render(displayContent))
+				if indicator != "" {
+					contentLines = append(contentLines, h.textContentStyle.Foreground(lipgloss.Color(h.Muted)).Render(indicator))
+				}
 				// Add separator line if there are also tab messages
 				if len(tabContent) > 0 {
 					contentLines = append(contentLines, "")
@@ -54,22 +115,22 @@ func (h *DevTUI) ContentView() string {
 		formattedMsg := h.formatMessage(content)
 		contentLines = append(contentLines, h.textContentStyle.Render(formattedMsg))
 	}
-	return Convert(contentLines).Join("\n").String()
+	return h.withFieldSidebar(section, Convert(contentLines).Join("\n").String())
 }
 
 func (h *DevTUI) headerView() string {
 	if len(h.TabSections) == 0 {
-		return h.headerTitleStyle.Render(h.AppName + "/No tabs")
+		return h.headerTitleStyle.Render(h.appTitle() + "/No tabs")
 	}
 	if h.activeTab >= len(h.TabSections) {
-		h.activeTab = 0
+		h.setActiveIndex(0)
 	}
 
 	tab := h.TabSections[h.activeTab]
 
 	// Truncar el título si es necesario
-	headerText := h.AppName + "/" + tab.title
-	truncatedHeader := Convert(headerText).Truncate(h.labelWidth, 0).String()
+	headerText := h.appTitle() + "/" + tab.currentTitle()
+	truncatedHeader := h.truncate(headerText, h.labelWidth)
 
 	// Aplicar el estilo base para garantizar un ancho fijo
 	fixedWidthHeader := h.labelStyle.Render(truncatedHeader)
@@ -90,6 +151,48 @@ func (h *DevTUI) headerView() string {
 	pagination := Fmt("%2d/%2d", displayCurrent, displayTotal)
 	paginationStyled := h.paginationStyle.Render(pagination)
 	lineWidth := h.viewport.Width - lipgloss.Width(title) - lipgloss.Width(paginationStyled)
+	right := h.headerSegment(h.HeaderRight, lineWidth)
+	lineWidth -= lipgloss.Width(right)
+	left := h.headerSegment(h.HeaderLeft, lineWidth)
+	lineWidth -= lipgloss.Width(left)
+	badges := h.unreadBadges(lineWidth)
+	lineWidth -= lipgloss.Width(badges)
 	line := h.lineHeadFootStyle.Render(Convert("─").Repeat(max(0, lineWidth)).String())
-	return lipgloss.JoinHorizontal(lipgloss.Center, title, line, paginationStyled)
+	return lipgloss.JoinHorizontal(lipgloss.Center, title, left, badges, line, right, paginationStyled)
+}
+
+// headerSegment renders the first line of segment (TuiConfig.HeaderLeft/HeaderRight)
+// within width columns; empty when segment is nil, returns nothing or doesn't fit
+func (h *DevTUI) headerSegment(segment func() string, width int) string {
+	if segment == nil {
+		return ""
+	}
+	text, _, _ := strings.Cut(segment(), "\n")
+	padding := h.headerSegmentStyle.GetHorizontalFrameSize()
+	text = h.truncate(text, width-padding)
+	if text == "" {
+		return ""
+	}
+	return h.headerSegmentStyle.Render(text)
+}
+
+// unreadBadges renders " Title(N)" for every inactive tab with unread messages,
+// keeping only the badges that fit in width
+func (h *DevTUI) unreadBadges(width int) string {
+	var badges string
+	for i, tab := range h.TabSections {
+		if i == h.activeTab {
+			continue
+		}
+		count := tab.unread()
+		if count == 0 {
+			continue
+		}
+		badge := h.unreadBadgeStyle.Render(Fmt(" %s(%d)", tab.currentTitle(), count))
+		if lipgloss.Width(badges)+lipgloss.Width(badge) > width {
+			break
+		}
+		badges += badge
+	}
+	return badges
 }
\ No newline at end of file
@@ -0,0 +1,112 @@
+package devtui
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestCtrlHomeEndJumpToExtremes(t *testing.T) {
+	h, _ := setupStickyScrollTest()
+	h.viewport.LineUp(5)
+
+	h.handleKeyboard(tea.KeyMsg{Type: tea.KeyCtrlHome})
+	if h.viewport.YOffset != 0 {
+		t.Errorf("Expected Ctrl+Home to jump to top, got YOffset %d", h.viewport.YOffset)
+	}
+
+	h.handleKeyboard(tea.KeyMsg{Type: tea.KeyCtrlEnd})
+	if !h.viewport.AtBottom() {
+		t.Errorf("Expected Ctrl+End to jump to bottom, got YOffset %d", h.viewport.YOffset)
+	}
+}
+
+func TestCtrlUDScrollHalfPage(t *testing.T) {
+	h, _ := setupStickyScrollTest()
+	h.viewport.Height = 10
+	h.viewport.GotoTop()
+
+	h.handleKeyboard(tea.KeyMsg{Type: tea.KeyCtrlD})
+	if h.viewport.YOffset != 5 {
+		t.Errorf("Expected Ctrl+D to scroll down half a page (5), got YOffset %d", h.viewport.YOffset)
+	}
+
+	h.handleKeyboard(tea.KeyMsg{Type: tea.KeyCtrlD})
+	h.handleKeyboard(tea.KeyMsg{Type: tea.KeyCtrlU})
+	if h.viewport.YOffset != 5 {
+		t.Errorf("Expected Ctrl+U to scroll back up half a page (5), got YOffset %d", h.viewport.YOffset)
+	}
+}
+
+func TestHomeAndVimKeysJumpToExtremes(t *testing.T) {
+	h, _ := setupStickyScrollTest()
+	h.viewport.LineUp(5)
+
+	h.handleKeyboard(tea.KeyMsg{Type: tea.KeyHome})
+	if h.viewport.YOffset != 0 {
+		t.Errorf("Expected Home to jump to top, got YOffset %d", h.viewport.YOffset)
+	}
+
+	h.handleKeyboard(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'G'}})
+	if !h.viewport.AtBottom() {
+		t.Errorf("Expected G to jump to bottom, got YOffset %d", h.viewport.YOffset)
+	}
+
+	h.handleKeyboard(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'g'}})
+	if h.viewport.YOffset != 0 {
+		t.Errorf("Expected g to jump to top, got YOffset %d", h.viewport.YOffset)
+	}
+}
+
+func TestVimJumpKeysYieldToShortcutsAndEditMode(t *testing.T) {
+	h, handler := setupSequenceShortcutTest(t)
+	h.activeTab = len(h.TabSections) - 1
+
+	// "g" is a registered shortcut (and prefix of "gb"): it must not be taken for scrolling
+	pressRune(h, 'g')
+	if h.pendingKeys != "g" {
+		t.Errorf("Expected g to start the registered shortcut sequence, got pending %q", h.pendingKeys)
+	}
+	pressRune(h, 'b')
+	if len(handler.values) != 1 || handler.values[0] != "gb" {
+		t.Errorf("Expected the gb shortcut to run, got %v", handler.values)
+	}
+
+	hEdit, field := setupTestWithEditableField(t)
+	hEdit.handleKeyboard(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'g'}})
+	if field.tempEditValue != "g" {
+		t.Errorf("Expected g to be typed in edit mode, got %q", field.tempEditValue)
+	}
+}
+
+// buildSequenceHandler only registers the "gb" sequence, so a lone "g" keeps jumping to the top
+type buildSequenceHandler struct{ sequenceShortcutHandler }
+
+func (h *buildSequenceHandler) Shortcuts() []map[string]string {
+	return []map[string]string{{"gb": "go build"}}
+}
+
+func TestLoneGJumpsToTopWhenPrefixOfSequence(t *testing.T) {
+	h, _ := setupStickyScrollTest()
+	handler := &buildSequenceHandler{}
+	h.AddHandler(handler, 0, "", h.TabSections[h.activeTab])
+
+	pressRune(h, 'g')
+	if h.pendingKeys != "g" {
+		t.Fatalf("Expected g to wait for the gb sequence, got pending %q", h.pendingKeys)
+	}
+	h.Update(shortcutTimeoutMsg{id: h.pendingKeysID})
+	if h.viewport.YOffset != 0 {
+		t.Errorf("Expected a lone g to jump to top after the timeout, got YOffset %d", h.viewport.YOffset)
+	}
+
+	h.viewport.GotoBottom()
+	pressRune(h, 'g')
+	pressRune(h, 'G') // not part of a sequence: g runs on its own, then G
+	if !h.viewport.AtBottom() {
+		t.Errorf("Expected g then G to end at the bottom, got YOffset %d", h.viewport.YOffset)
+	}
+	if len(handler.values) != 0 {
+		t.Errorf("Expected no shortcut to run, got %v", handler.values)
+	}
+}
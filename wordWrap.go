@@ -0,0 +1,67 @@
+package devtui
+
+import (
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// wordWrap splits a single line into segments no wider than width (terminal cells),
+// breaking at spaces when possible and hard-breaking words longer than width.
+// A width <= 0 disables wrapping.
+func wordWrap(line string, width int) []string {
+	if width <= 0 || lipgloss.Width(line) <= width {
+		return []string{line}
+	}
+
+	var lines []string
+	var current strings.Builder
+	currentWidth := 0
+
+	flush := func() {
+		lines = append(lines, current.String())
+		current.Reset()
+		currentWidth = 0
+	}
+
+	for _, word := range strings.Split(line, " ") {
+		wordWidth := lipgloss.Width(word)
+
+		// Word fits on the current line (with a separating space if needed)
+		if currentWidth > 0 && currentWidth+1+wordWidth <= width {
+			current.WriteString(" ")
+			current.WriteString(word)
+			currentWidth += 1 + wordWidth
+			continue
+		}
+		if currentWidth > 0 {
+			flush()
+		}
+
+		// Hard-break words that are wider than a full line
+		for wordWidth > width {
+			var part strings.Builder
+			partWidth := 0
+			runes := []rune(word)
+			i := 0
+			for ; i < len(runes); i++ {
+				w := lipgloss.Width(string(runes[i]))
+				if partWidth+w > width && partWidth > 0 {
+					break
+				}
+				part.WriteRune(runes[i])
+				partWidth += w
+			}
+			lines = append(lines, part.String())
+			word = string(runes[i:])
+			wordWidth = lipgloss.Width(word)
+		}
+
+		current.WriteString(word)
+		currentWidth = wordWidth
+	}
+	if currentWidth > 0 || current.Len() > 0 {
+		flush()
+	}
+	return lines
+}
@@ -0,0 +1,81 @@
+package devtui
+
+import (
+	"strings"
+	"testing"
+
+	. "github.com/cdvelop/tinystring"
+	"github.com/charmbracelet/lipgloss"
+)
+
+func TestWordWrap(t *testing.T) {
+	t.Run("Short line is not wrapped", func(t *testing.T) {
+		got := wordWrap("hello world", 80)
+		if len(got) != 1 || got[0] != "hello world" {
+			t.Errorf("Expected single unchanged line, got %q", got)
+		}
+	})
+
+	t.Run("Wraps at word boundaries", func(t *testing.T) {
+		got := wordWrap("aaa bbb ccc ddd", 7)
+		expected := []string{"aaa bbb", "ccc ddd"}
+		if strings.Join(got, "|") != strings.Join(expected, "|") {
+			t.Errorf("Expected %q, got %q", expected, got)
+		}
+	})
+
+	t.Run("Hard-breaks long words", func(t *testing.T) {
+		got := wordWrap("abcdefghij", 4)
+		expected := []string{"abcd", "efgh", "ij"}
+		if strings.Join(got, "|") != strings.Join(expected, "|") {
+			t.Errorf("Expected %q, got %q", expected, got)
+		}
+	})
+
+	t.Run("Wide runes respect cell width", func(t *testing.T) {
+		for _, line := range wordWrap("你好世界你好世界", 5) {
+			if w := lipgloss.Width(line); w > 5 {
+				t.Errorf("Line %q has width %d > 5", line, w)
+			}
+		}
+	})
+}
+
+func TestLongMessageWrapsToViewportWidth(t *testing.T) {
+	h := DefaultTUIForTest()
+	h.viewport.Width = 80
+	tab := h.NewTabSection("Wrap", "Word wrap test")
+	h.AddHandler(NewTestEditableHandler("Wrap", "v"), 0, "", tab)
+
+	words := make([]string, 0, 40)
+	for len(strings.Join(words, " ")) < 200 {
+		words = append(words, "word")
+	}
+	message := strings.Join(words, " ")[:200]
+	message = message[:199] + "x" // avoid a trailing space so content can be compared exactly
+
+	tc := h.createTabContent(message, Msg.Normal, tab.(*tabSection), "WrapHandler", "", "")
+	formatted := h.formatMessage(tc)
+	lines := strings.Split(formatted, "\n")
+
+	if len(lines) < 3 {
+		t.Fatalf("Expected at least 3 wrapped lines for a 200-char message at width 80, got %d:\n%s", len(lines), formatted)
+	}
+
+	maxWidth := h.viewport.Width - h.textContentStyle.GetHorizontalFrameSize()
+	prefixWidth := lipgloss.Width(lines[0][:strings.Index(lines[0], "word")])
+	var rebuilt []string
+	for i, line := range lines {
+		if w := lipgloss.Width(line); w > maxWidth {
+			t.Errorf("Line %d width %d exceeds %d: %q", i, w, maxWidth, line)
+		}
+		if i > 0 && !strings.HasPrefix(line, strings.Repeat(" ", prefixWidth)) {
+			t.Errorf("Continuation line %d should be indented by %d: %q", i, prefixWidth, line)
+		}
+		rebuilt = append(rebuilt, strings.TrimSpace(line[strings.Index(line, "word"):]))
+	}
+
+	if got := strings.Join(rebuilt, " "); got != message {
+		t.Errorf("Wrapped content not preserved.\nExpected: %q\nGot:      %q", message, got)
+	}
+}
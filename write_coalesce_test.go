@@ -0,0 +1,67 @@
+package devtui
+
+import (
+	"testing"
+	"time"
+)
+
+// pumpMessages runs the message pump like tea does until total messages were
+// delivered, returning how many Update calls were needed (tea redraws after each one).
+func pumpMessages(h *DevTUI, total int) (updates int) {
+	received := 0
+	for received < total {
+		switch msg := h.listenToMessages()().(type) {
+		case channelMsg:
+			received++
+			h.Update(msg)
+		case channelBatchMsg:
+			received += len(msg)
+			h.Update(msg)
+		}
+		updates++
+	}
+	return updates
+}
+
+func writeRapidly(h *DevTUI, total int) int {
+	// Tab stays inactive so the test measures Update cycles, not ContentView cost
+	tab := h.NewTabSection("Logs", "High frequency")
+	log := h.AddLogger("Flood", false, "", tab)
+
+	go func() {
+		for i := 0; i < total; i++ {
+			log("entry", i)
+		}
+	}()
+	return pumpMessages(h, total)
+}
+
+func TestWriteCoalesceReducesViewportUpdates(t *testing.T) {
+	const total = 1000
+
+	plain := DefaultTUIForTest()
+	plainUpdates := writeRapidly(plain, total)
+	if plainUpdates != total {
+		t.Errorf("Without coalescing expected %d updates, got %d", total, plainUpdates)
+	}
+
+	coalesced := NewTUI(&TuiConfig{ExitChan: make(chan bool), WriteCoalesceInterval: 20 * time.Millisecond})
+	coalesced.SetTestMode(true)
+	coalescedUpdates := writeRapidly(coalesced, total)
+	if coalescedUpdates > total/10 {
+		t.Errorf("With coalescing expected far fewer than %d updates, got %d", total, coalescedUpdates)
+	}
+	t.Logf("viewport updates: plain=%d coalesced=%d", plainUpdates, coalescedUpdates)
+
+	if got := len(coalesced.TabSections[len(coalesced.TabSections)-1].tabContents); got != total {
+		t.Errorf("Expected all %d messages kept, got %d", total, got)
+	}
+}
+
+func BenchmarkWriteCoalesce(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		h := NewTUI(&TuiConfig{ExitChan: make(chan bool), WriteCoalesceInterval: time.Millisecond})
+		h.SetTestMode(true)
+		writeRapidly(h, 1000)
+	}
+}
@@ -0,0 +1,58 @@
+package devtui
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestWriteToHandlerRoutesByName(t *testing.T) {
+	h := DefaultTUIForTest()
+	tab := h.NewTabSection("Build", "Write to handler test")
+	h.AddLogger("Builder", false, "#123456", tab)
+	ts := tab.(*tabSection)
+
+	if err := ts.WriteToHandler("Builder", "compiled", 3, "files"); err != nil {
+		t.Fatalf("WriteToHandler returned error: %v", err)
+	}
+
+	if len(ts.tabContents) != 1 {
+		t.Fatalf("Expected 1 message, got %d", len(ts.tabContents))
+	}
+	msg := ts.tabContents[0]
+	if msg.RawHandlerName != "Builder" || msg.handlerColor != "#123456" {
+		t.Errorf("Expected message from Builder with its color, got %q %q", msg.RawHandlerName, msg.handlerColor)
+	}
+	if !strings.Contains(msg.Content, "compiled") || !strings.Contains(msg.Content, "3") {
+		t.Errorf("Unexpected content %q", msg.Content)
+	}
+}
+
+func TestWriteToHandlerUsesTrackedLine(t *testing.T) {
+	h := DefaultTUIForTest()
+	tab := h.NewTabSection("Build", "Write to handler test")
+	log := h.AddLogger("Deployer", true, "", tab)
+	ts := tab.(*tabSection)
+
+	log("deploying...")
+	if err := ts.WriteToHandler("Deployer", "deployed"); err != nil {
+		t.Fatalf("WriteToHandler returned error: %v", err)
+	}
+
+	if len(ts.tabContents) != 1 || ts.tabContents[0].Content != "deployed" {
+		t.Errorf("Expected tracked line to be updated, got %d lines", len(ts.tabContents))
+	}
+}
+
+func TestWriteToHandlerUnknownName(t *testing.T) {
+	h := DefaultTUIForTest()
+	tab := h.NewTabSection("Build", "Write to handler test")
+	ts := tab.(*tabSection)
+
+	err := ts.WriteToHandler("Missing", "hello")
+	if err == nil || !strings.Contains(err.Error(), "Missing") {
+		t.Errorf("Expected error naming the missing writer, got %v", err)
+	}
+	if len(ts.tabContents) != 0 {
+		t.Error("Expected no message for an unknown writer")
+	}
+}